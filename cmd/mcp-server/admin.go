@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+)
+
+// runningSearch is a search in progress, as shown to operators.
+type runningSearch struct {
+	id        string
+	tool      string
+	route     string
+	startedAt time.Time
+	progress  *cheapoffers.Progress
+}
+
+// searchTracker keeps track of the running searches.
+type searchTracker struct {
+	mu      sync.Mutex
+	next    int
+	running map[string]*runningSearch
+}
+
+func newSearchTracker() *searchTracker {
+	return &searchTracker{running: map[string]*runningSearch{}}
+}
+
+// Start registers a search and returns its progress and the function to call when
+// the search is over.
+func (t *searchTracker) Start(tool, route string) (*cheapoffers.Progress, func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.next++
+	search := &runningSearch{
+		id:        fmt.Sprintf("%d", t.next),
+		tool:      tool,
+		route:     route,
+		startedAt: time.Now(),
+		progress:  &cheapoffers.Progress{},
+	}
+	t.running[search.id] = search
+	return search.progress, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.running, search.id)
+	}
+}
+
+type runningSearchResponse struct {
+	ID           string  `json:"id"`
+	Tool         string  `json:"tool"`
+	Route        string  `json:"route"`
+	StartedAt    string  `json:"startedAt"`
+	RunningFor   float64 `json:"runningForSeconds"`
+	PendingDates int     `json:"pendingDates"`
+	ActiveDates  int     `json:"activeDates"`
+	DoneDates    int     `json:"doneDates"`
+	ETA          float64 `json:"etaSeconds,omitempty"` // omitted until a date is done
+}
+
+type searchesResponse struct {
+	Searches         []runningSearchResponse `json:"searches"`
+	ActiveDates      int                     `json:"activeDates"`      // dates being verified across all searches
+	UpstreamInFlight int                     `json:"upstreamInFlight"` // upstream requests currently running
+}
+
+func (t *searchTracker) snapshot(now time.Time) []runningSearchResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	searches := make([]runningSearchResponse, 0, len(t.running))
+	for _, search := range t.running {
+		p := search.progress.Snapshot()
+		response := runningSearchResponse{
+			ID:           search.id,
+			Tool:         search.tool,
+			Route:        search.route,
+			StartedAt:    search.startedAt.Format(time.RFC3339),
+			RunningFor:   now.Sub(search.startedAt).Seconds(),
+			PendingDates: p.Pending,
+			ActiveDates:  p.Active,
+			DoneDates:    p.Done,
+		}
+		// The dates are verified up to the search's concurrency at a time, so the
+		// remaining dates take about as long as one more date per batch of them.
+		if p.AverageDateDuration > 0 {
+			batches := 1 + p.Pending/max(p.Active, 1)
+			response.ETA = (time.Duration(batches) * p.AverageDateDuration).Seconds()
+		}
+		searches = append(searches, response)
+	}
+	sort.Slice(searches, func(i, j int) bool {
+		return searches[i].StartedAt < searches[j].StartedAt
+	})
+	return searches
+}
+
+// adminHandler serves the operator endpoints:
+//
+//	/admin/searches  running searches as JSON
+//	/metrics         the same numbers in the Prometheus text format
+//
+// All requests need the admin token as a bearer token.
+func (s *server) adminHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/searches", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.searchesStatus())
+	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		s.writeMetrics(w)
+	})
+	return requireBearerToken(token, mux)
+}
+
+func (s *server) searchesStatus() searchesResponse {
+	response := searchesResponse{Searches: s.tracker.snapshot(time.Now())}
+	for _, search := range response.Searches {
+		response.ActiveDates += search.ActiveDates
+	}
+	s.usage.mu.Lock()
+	response.UpstreamInFlight = s.usage.inFlight
+	s.usage.mu.Unlock()
+	return response
+}
+
+func (s *server) writeMetrics(w http.ResponseWriter) {
+	status := s.searchesStatus()
+	var pending, done int
+	for _, search := range status.Searches {
+		pending += search.PendingDates
+		done += search.DoneDates
+	}
+	s.usage.mu.Lock()
+	s.usage.rollOver()
+	usedToday := s.usage.used
+	clients := make([]string, 0, len(s.usage.usedBy))
+	usedBy := make(map[string]int, len(s.usage.usedBy))
+	for client, used := range s.usage.usedBy {
+		clients = append(clients, client)
+		usedBy[client] = used
+	}
+	s.usage.mu.Unlock()
+	sort.Strings(clients)
+
+	metrics := []struct {
+		name, help string
+		value      int
+	}{
+		{"flights_searches_running", "Number of running searches.", len(status.Searches)},
+		{"flights_dates_pending", "Price graph dates of running searches waiting to be verified.", pending},
+		{"flights_dates_active", "Price graph dates being verified.", status.ActiveDates},
+		{"flights_dates_done", "Price graph dates of running searches already verified.", done},
+		{"flights_upstream_requests_in_flight", "Upstream Google Flights requests currently running.", status.UpstreamInFlight},
+		{"flights_upstream_requests_today", "Upstream Google Flights requests issued during the current UTC day.", usedToday},
+		{"flights_search_goroutines", "Goroutines of all searches verifying dates, bounded by --max-search-goroutines.", s.guardrails.Goroutines()},
+		{"flights_buffered_result_bytes", "Approximate memory of the results buffered by running searches, bounded by --max-result-memory-mb.", int(s.guardrails.BufferedBytes())},
+	}
+	for _, m := range metrics {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s %d\n", m.name, m.help, m.name, m.name, m.value)
+	}
+
+	const byClient = "flights_upstream_requests_today_by_client"
+	fmt.Fprintf(w, "# HELP %s Upstream Google Flights requests issued during the current UTC day per MCP client or background job, clients beyond the first %d of the day are counted as %q.\n# TYPE %s gauge\n", byClient, maxTrackedClients, otherClients, byClient)
+	for _, client := range clients {
+		fmt.Fprintf(w, "%s{client=\"%s\"} %d\n", byClient, labelEscaper.Replace(client), usedBy[client])
+	}
+}
+
+// labelEscaper escapes label values for the Prometheus text format, which only
+// knows the escapes of backslashes, double quotes and line feeds.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestAdminShowsRunningSearches(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 3)
+	start := time.Now().AddDate(0, 1, 0)
+
+	session := &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			var offers []flights.Offer
+			for i := 0; i < 3; i++ {
+				date := start.AddDate(0, 0, i)
+				offers = append(offers, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: 100})
+			}
+			return offers, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			started <- struct{}{}
+			select {
+			case <-release:
+			case <-ctx.Done():
+			}
+			return nil, nil, ctx.Err()
+		},
+	}
+	s := newTestServer(t, session)
+	clientSession := connect(t, s)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		clientSession.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "find_cheapest_offers",
+			Arguments: findCheapestOffersArgs(),
+		})
+	}()
+	for i := 0; i < 3; i++ {
+		<-started
+	}
+
+	admin := s.adminHandler("secret")
+
+	rec := httptest.NewRecorder()
+	admin.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/searches", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without a token, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/searches", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	admin.ServeHTTP(rec, req)
+	var status searchesResponse
+	if err := json.NewDecoder(rec.Body).Decode(&status); err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Searches) != 1 || status.Searches[0].ActiveDates != 3 || status.Searches[0].Route != "San Francisco -> New York" {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+	if status.UpstreamInFlight != 3 {
+		t.Fatalf("expected 3 upstream requests in flight, got %d", status.UpstreamInFlight)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	admin.ServeHTTP(rec, req)
+	if !strings.Contains(rec.Body.String(), "\nflights_searches_running 1\n") {
+		t.Fatalf("unexpected metrics:\n%s", rec.Body.String())
+	}
+
+	close(release)
+	<-done
+	if searches := s.tracker.snapshot(time.Now()); len(searches) != 0 {
+		t.Fatalf("finished searches should be removed, got: %+v", searches)
+	}
+}
+
+func TestMetricsEscapeClients(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	ctx := withCallMeta(context.Background(), callMeta{Client: "a \"b\" \\ é"})
+	if _, err := s.usage.GetPriceGraph(ctx, flights.PriceGraphArgs{}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.writeMetrics(rec)
+	expected := `flights_upstream_requests_today_by_client{client="a \"b\" \\ é"} 1` + "\n"
+	if !strings.Contains(rec.Body.String(), expected) {
+		t.Fatalf("expected %q in the metrics:\n%s", expected, rec.Body.String())
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/krisukox/google-flights-api/iata"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/text/language"
+)
+
+// defaultMaxLookupCities is the number of cities airport_lookup returns unless
+// maxResults says otherwise.
+const defaultMaxLookupCities = 10
+
+type airportLookupParams struct {
+	Query      string `json:"query" jsonschema:"Free-text city name or IATA airport code, e.g. 'new york' or 'jfk'"`
+	MaxResults int    `json:"maxResults,omitempty" jsonschema:"Optional maximum number of cities to return, defaults to 10"`
+	Language   string `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag of the local city names, defaults to en"`
+}
+
+type lookupAirportResponse struct {
+	Code     string `json:"code"`
+	TimeZone string `json:"timeZone"`
+}
+
+type lookupCityResponse struct {
+	City      string                  `json:"city"`                // empty for airports without a city, pass to the srcCities and dstCities of searches
+	LocalName string                  `json:"localName,omitempty"` // city name in the requested language if it differs from city
+	Airports  []lookupAirportResponse `json:"airports"`
+}
+
+type airportLookupResponse struct {
+	SchemaVersion int                  `json:"schemaVersion"`
+	Cities        []lookupCityResponse `json:"cities"` // best matches first
+}
+
+func (s *server) airportLookup(_ context.Context, _ *mcp.CallToolRequest, params airportLookupParams) (*mcp.CallToolResult, airportLookupResponse, error) {
+	if strings.TrimSpace(params.Query) == "" {
+		return nil, airportLookupResponse{}, fmt.Errorf("query is required")
+	}
+	if params.MaxResults < 0 {
+		return nil, airportLookupResponse{}, fmt.Errorf("maxResults must not be negative")
+	}
+	if params.MaxResults == 0 {
+		params.MaxResults = defaultMaxLookupCities
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, airportLookupResponse{}, err
+	}
+
+	response := airportLookupResponse{SchemaVersion: s.schemaVersion, Cities: []lookupCityResponse{}}
+	index := map[string]int{}
+	for _, a := range iata.Lookup(params.Query) {
+		// The English name corrects the local names of the airport list, e.g.
+		// Venice instead of Venezia.
+		english := iata.CityName(a.Code, language.English)
+		i, ok := index[english]
+		if !ok {
+			if len(response.Cities) == params.MaxResults {
+				continue
+			}
+			i = len(response.Cities)
+			index[english] = i
+			city := lookupCityResponse{City: english}
+			if name := iata.CityName(a.Code, lang); name != english {
+				city.LocalName = name
+			}
+			response.Cities = append(response.Cities, city)
+		}
+		response.Cities[i].Airports = append(response.Cities[i].Airports, lookupAirportResponse{Code: a.Code, TimeZone: a.Tz})
+	}
+
+	var summary strings.Builder
+	if len(response.Cities) == 0 {
+		summary.WriteString(fmt.Sprintf("No city or airport matches %q. Try a shorter query or the English city name.", params.Query))
+	}
+	for i, c := range response.Cities {
+		if i > 0 {
+			summary.WriteString("\n")
+		}
+		codes := make([]string, 0, len(c.Airports))
+		for _, a := range c.Airports {
+			codes = append(codes, a.Code)
+		}
+		city := c.City
+		if city == "" {
+			city = "(no city, use the airport code)"
+		}
+		if c.LocalName != "" {
+			city = fmt.Sprintf("%s (%s)", c.LocalName, c.City)
+		}
+		summary.WriteString(fmt.Sprintf("%s: %s", city, strings.Join(codes, ", ")))
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+// routeName names the route from the origins to the destinations in lang, e.g.
+// "München (MUC) -> New York". The city names the name table knows are translated
+// and airports are named by their city.
+func routeName(srcCities, srcAirports, dstCities, dstAirports []string, lang language.Tag) string {
+	return placeNames(srcCities, srcAirports, lang) + " -> " + placeNames(dstCities, dstAirports, lang)
+}
+
+func placeNames(cities, airports []string, lang language.Tag) string {
+	names := make([]string, 0, len(cities)+len(airports))
+	for _, city := range cities {
+		if name, ok := iata.TranslateCity(city, lang); ok {
+			city = name
+		}
+		names = append(names, city)
+	}
+	for _, code := range airports {
+		if name := iata.CityName(code, lang); name != "" {
+			code = fmt.Sprintf("%s (%s)", name, code)
+		}
+		names = append(names, code)
+	}
+	return strings.Join(names, "/")
+}
+
+// checkAirportCodes upper-cases the IATA codes of the lists in place, as Google
+// Flights expects them, and rejects the codes that it doesn't support, so that a
+// search fails with a hint instead of silently finding nothing.
+func checkAirportCodes(lists ...[]string) error {
+	for _, codes := range lists {
+		for i, code := range codes {
+			if !iata.Supported(code) {
+				return fmt.Errorf("unknown airport code %q, use airport_lookup to find the code", code)
+			}
+			codes[i] = strings.ToUpper(code)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"golang.org/x/text/language"
+)
+
+func TestAirportLookup(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+
+	_, response, err := s.airportLookup(context.Background(), nil, airportLookupParams{Query: "sfo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Cities) == 0 || response.Cities[0].City != "San Francisco" || response.Cities[0].Airports[0].Code != "SFO" {
+		t.Fatalf("expected San Francisco first for its airport code, got %+v", response.Cities)
+	}
+
+	_, response, err = s.airportLookup(context.Background(), nil, airportLookupParams{Query: "new york", MaxResults: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Cities) != 1 || response.Cities[0].City != "New York" {
+		t.Fatalf("expected only New York, got %+v", response.Cities)
+	}
+	codes := map[string]bool{}
+	for _, a := range response.Cities[0].Airports {
+		codes[a.Code] = true
+	}
+	if !codes["JFK"] || !codes["LGA"] {
+		t.Fatalf("expected JFK and LGA for New York, got %+v", response.Cities[0].Airports)
+	}
+
+	_, response, err = s.airportLookup(context.Background(), nil, airportLookupParams{Query: "atlantis"})
+	if err != nil || len(response.Cities) != 0 {
+		t.Fatalf("expected no matches, got %+v, %v", response.Cities, err)
+	}
+	if _, _, err := s.airportLookup(context.Background(), nil, airportLookupParams{Query: " "}); err == nil {
+		t.Fatal("expected an error for an empty query")
+	}
+
+	_, response, err = s.airportLookup(context.Background(), nil, airportLookupParams{Query: "muc", Language: "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Cities) == 0 || response.Cities[0].City != "Munich" || response.Cities[0].LocalName != "München" {
+		t.Fatalf("expected Munich with its German name, got %+v", response.Cities)
+	}
+	_, response, err = s.airportLookup(context.Background(), nil, airportLookupParams{Query: "sfo", Language: "de"})
+	if err != nil || len(response.Cities) == 0 || response.Cities[0].LocalName != "" {
+		t.Fatalf("expected no local name for San Francisco, got %+v, %v", response.Cities, err)
+	}
+	_, response, err = s.airportLookup(context.Background(), nil, airportLookupParams{Query: "vce"})
+	if err != nil || len(response.Cities) == 0 || response.Cities[0].City != "Venice" || response.Cities[0].LocalName != "" {
+		t.Fatalf("expected Venice instead of the Italian name of the airport list, got %+v, %v", response.Cities, err)
+	}
+}
+
+func TestRouteName(t *testing.T) {
+	for _, tc := range []struct {
+		srcCities, srcAirports, dstCities, dstAirports []string
+		lang                                           language.Tag
+		expected                                       string
+	}{
+		{[]string{"Munich"}, nil, []string{"New York"}, nil, language.German, "München -> New York"},
+		{nil, []string{"MUC", "NUE"}, []string{"Venezia"}, nil, language.French, "Munich (MUC)/Nuremberg (NUE) -> Venise"},
+		{[]string{"Atlantis"}, nil, nil, []string{"VCE"}, language.English, "Atlantis -> Venice (VCE)"},
+	} {
+		if route := routeName(tc.srcCities, tc.srcAirports, tc.dstCities, tc.dstAirports, tc.lang); route != tc.expected {
+			t.Errorf("routeName(%v, %v, %v, %v, %s) = %q, expected %q", tc.srcCities, tc.srcAirports, tc.dstCities, tc.dstAirports, tc.lang, route, tc.expected)
+		}
+	}
+}
+
+func TestFindCheapestOffersByAirport(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		searched []flights.PriceGraphArgs
+	)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			searched = append(searched, args)
+			return nil, nil
+		},
+	})
+	start := time.Now().AddDate(0, 1, 0)
+	params := findCheapestOffersParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		TripLengths:    []int{7},
+		SrcAirports:    []string{"SFO"},
+		DstCities:      []string{"New York"},
+	}
+	if _, _, err := s.findCheapestOffers(context.Background(), nil, params); err != nil {
+		t.Fatal(err)
+	}
+	if len(searched) != 1 || len(searched[0].SrcAirports) != 1 || searched[0].SrcAirports[0] != "SFO" || searched[0].DstCities[0] != "New York" {
+		t.Fatalf("wrong price graph arguments: %+v", searched)
+	}
+
+	params.SrcAirports = []string{"XQZ"}
+	if _, _, err := s.findCheapestOffers(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error for an unknown airport code")
+	}
+	params.SrcAirports = nil
+	if _, _, err := s.findCheapestOffers(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error without a source city or airport")
+	}
+}
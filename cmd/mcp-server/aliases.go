@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultToolAliases keeps the names of renamed tools working for existing client
+// configurations.
+const defaultToolAliases = "Find Cheapest Offers=find_cheapest_offers"
+
+// toolAliases maps tool names to the legacy names the tool is also registered under.
+type toolAliases map[string][]string
+
+// parseToolAliases parses a comma-separated list of alias=tool pairs.
+func parseToolAliases(value string) (toolAliases, error) {
+	aliases := toolAliases{}
+	seen := map[string]bool{}
+	for _, pair := range splitList(value) {
+		alias, tool, ok := strings.Cut(pair, "=")
+		alias, tool = strings.TrimSpace(alias), strings.TrimSpace(tool)
+		if !ok || alias == "" || tool == "" {
+			return nil, fmt.Errorf("invalid tool alias %q, expected alias=tool", pair)
+		}
+		if seen[alias] {
+			return nil, fmt.Errorf("duplicate tool alias %q", alias)
+		}
+		seen[alias] = true
+		aliases[tool] = append(aliases[tool], alias)
+	}
+	return aliases, nil
+}
+
+// check verifies that every alias points at a registered tool and doesn't shadow one.
+func (a toolAliases) check(tools []string) error {
+	for tool, aliases := range a {
+		if !slices.Contains(tools, tool) {
+			return fmt.Errorf("tool alias %q refers to unknown tool %q", aliases[0], tool)
+		}
+		for _, alias := range aliases {
+			if slices.Contains(tools, alias) {
+				return fmt.Errorf("tool alias %q has the name of an existing tool", alias)
+			}
+		}
+	}
+	return nil
+}
+
+// mutatingTools change the price watches, trips or saved searches. --read-only
+// leaves them unregistered; searches still register their offers for
+// recheck_offer and diff_searches.
+var mutatingTools = map[string]bool{
+	"delete_search":      true,
+	"restore_search":     true,
+	"create_price_watch": true,
+	"delete_price_watch": true,
+	"restore_watch":      true,
+	"pause_trip":         true,
+	"resume_trip":        true,
+}
+
+// toolRegistry registers tools together with their localized metadata and aliases.
+type toolRegistry struct {
+	server   *mcp.Server
+	texts    toolTexts
+	aliases  toolAliases
+	readOnly bool // skip mutatingTools
+
+	// toolNames are the names of the tools without aliases, including the ones
+	// readOnly skips, so that the aliases of a configuration are valid in both modes.
+	toolNames []string
+}
+
+func addTool[In, Out any](r *toolRegistry, tool *mcp.Tool, handler mcp.ToolHandlerFor[In, Out]) {
+	r.toolNames = append(r.toolNames, tool.Name)
+	if r.readOnly && mutatingTools[tool.Name] {
+		return
+	}
+	tool = r.texts.localize(tool)
+	if hint, ok := toolDurations[tool.Name]; ok {
+		tool.Meta = mcp.Meta{"durationHint": hint}
+	}
+	mcp.AddTool(r.server, tool, handler)
+
+	for _, alias := range r.aliases[tool.Name] {
+		aliasTool := *tool
+		aliasTool.Name = alias
+		aliasTool.Description = fmt.Sprintf("Deprecated alias of %s, use that tool instead. %s", tool.Name, tool.Description)
+		mcp.AddTool(r.server, &aliasTool, handler)
+	}
+}
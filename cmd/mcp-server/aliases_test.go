@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestParseToolAliases(t *testing.T) {
+	aliases, err := parseToolAliases(defaultToolAliases + ", cheapest=find_cheapest_offers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := aliases["find_cheapest_offers"]; len(got) != 2 || got[0] != "Find Cheapest Offers" || got[1] != "cheapest" {
+		t.Fatalf("wrong aliases: %v", got)
+	}
+
+	for _, value := range []string{"find_cheapest_offers", "=find_cheapest_offers", "a=b,a=c"} {
+		if _, err := parseToolAliases(value); err == nil {
+			t.Fatalf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestCheckToolAliases(t *testing.T) {
+	tools := []string{"find_cheapest_offers", "server_info"}
+	if err := (toolAliases{"find_cheapest_offers": {"Find Cheapest Offers"}}).check(tools); err != nil {
+		t.Fatal(err)
+	}
+	if err := (toolAliases{"find_offers": {"Find Cheapest Offers"}}).check(tools); err == nil {
+		t.Fatal("expected an error for an alias of an unknown tool")
+	}
+	if err := (toolAliases{"find_cheapest_offers": {"server_info"}}).check(tools); err == nil {
+		t.Fatal("expected an error for an alias shadowing a tool")
+	}
+}
+
+func TestLegacyToolName(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	aliases, err := parseToolAliases(defaultToolAliases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.toolAliases = aliases
+	clientSession := connect(t, s)
+
+	result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "Find Cheapest Offers",
+		Arguments: findCheapestOffersArgs(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.IsError {
+		t.Fatalf("the legacy tool name failed: %+v", result.Content)
+	}
+}
+
+func TestReadOnlyTools(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	registered := s.addTools(mcp.NewServer(&mcp.Implementation{Name: serverName}, nil))
+	for name := range mutatingTools {
+		if !slices.Contains(registered, name) {
+			t.Errorf("mutating tool %s isn't registered", name)
+		}
+	}
+
+	s.readOnly = true
+	tools, err := connect(t, s).ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listed := map[string]bool{}
+	for _, tool := range tools.Tools {
+		listed[tool.Name] = true
+	}
+	for name := range mutatingTools {
+		if listed[name] {
+			t.Errorf("%s is listed in read-only mode", name)
+		}
+	}
+	for _, name := range []string{"find_cheapest_offers", "search_flights", "list_price_watches", "diff_searches"} {
+		if !listed[name] {
+			t.Errorf("%s is missing in read-only mode", name)
+		}
+	}
+}
+
+func TestReadOnlyToolAliases(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	aliases, err := parseToolAliases(defaultToolAliases + ", watch=create_price_watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.toolAliases = aliases
+	s.readOnly = true
+	if err := aliases.check(s.addTools(mcp.NewServer(&mcp.Implementation{Name: serverName}, nil))); err != nil {
+		t.Fatalf("expected the alias of a mutating tool to be valid in read-only mode: %v", err)
+	}
+
+	tools, err := connect(t, s).ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tool := range tools.Tools {
+		if tool.Name == "watch" {
+			t.Fatal("the alias of a mutating tool is listed in read-only mode")
+		}
+	}
+}
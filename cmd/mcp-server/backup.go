@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// backupVersion is the version of the backup format. restore refuses backups of a
+// newer version.
+const backupVersion = 1
+
+// stateBackup is the archive the backup subcommand writes and restore reads.
+type stateBackup struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	Store     string    `json:"store"` // backend the state was backed up from
+	State     storeDump `json:"state"`
+}
+
+// backupStore writes the state of st to the file at path. The file is replaced
+// atomically, so that a failed backup doesn't destroy an earlier one.
+func backupStore(ctx context.Context, st store, backend, path string) (stateBackup, error) {
+	dump, err := st.Dump(ctx)
+	if err != nil {
+		return stateBackup{}, fmt.Errorf("dump %s store: %v", backend, err)
+	}
+	backup := stateBackup{Version: backupVersion, CreatedAt: time.Now().UTC(), Store: backend, State: dump}
+	data, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		return stateBackup{}, err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return stateBackup{}, err
+	}
+	return backup, os.Rename(tmp, path)
+}
+
+// restoreStore replaces the state of st with the backup at path.
+func restoreStore(ctx context.Context, st store, path string) (stateBackup, error) {
+	var backup stateBackup
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return backup, err
+	}
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return backup, fmt.Errorf("parse %s: %v", path, err)
+	}
+	if backup.Version < 1 || backup.Version > backupVersion {
+		return backup, fmt.Errorf("%s has backup version %d, this server reads versions up to %d", path, backup.Version, backupVersion)
+	}
+	return backup, st.Restore(ctx, backup.State)
+}
+
+// runBackup runs the backup subcommand.
+func runBackup(st store, backend, path string) int {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	backup, err := backupStore(ctx, st, backend, path)
+	if err != nil {
+		log.Printf("backup: %v", err)
+		return 1
+	}
+	log.Printf("backed up %s to %s", describeDump(backup.State), path)
+	return 0
+}
+
+// runRestore runs the restore subcommand. It locks the store, so that it doesn't
+// replace the state under a running server.
+func runRestore(st store, backend, path string) int {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	if err := st.Lock(ctx); err != nil {
+		log.Printf("restore: %v", err)
+		return 1
+	}
+	backup, err := restoreStore(ctx, st, path)
+	if err != nil {
+		log.Printf("restore: %v", err)
+		return 1
+	}
+	if backend == "memory" {
+		log.Printf("restored %d watches from %s; the memory store doesn't keep offers, searches and fare histories across restarts, use the sqlite or postgres store to restore them", len(backup.State.Watches.Watches), path)
+		return 0
+	}
+	log.Printf("restored %s from %s", describeDump(backup.State), path)
+	return 0
+}
+
+func describeDump(dump storeDump) string {
+	return fmt.Sprintf("%d watches, %d offers, %d searches and %d fare histories",
+		len(dump.Watches.Watches), len(dump.Offers), len(dump.Searches), len(dump.Fares))
+}
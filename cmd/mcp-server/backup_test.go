@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"golang.org/x/text/currency"
+)
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	source, err := newStore(storeConfig{Backend: "sqlite", DSN: filepath.Join(dir, "source.db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+
+	args := flights.Args{Date: time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC), SrcAirports: []string{"BER"}, DstAirports: []string{"FCO"}, Options: flights.OptionsDefault()}
+	args.Currency = currency.EUR
+	if err := source.SaveOffer(ctx, "offer-1", registeredOffer{Args: args, Price: 199}); err != nil {
+		t.Fatal(err)
+	}
+	kept, err := source.SaveSearch(ctx, []offerResponse{{ID: "offer-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := source.SaveSearch(ctx, []offerResponse{{ID: "offer-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.DeleteSearch(ctx, deleted); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.ObserveFare(ctx, "fare-1", pricePoint{At: time.Now(), Price: 120}); err != nil {
+		t.Fatal(err)
+	}
+	watches := watchListFile{Watches: []priceWatch{{ID: "abc", Params: watchParams(), LastPrice: 240}}}
+	if err := source.SaveWatches(ctx, watches); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "backup.json")
+	if _, err := backupStore(ctx, source, "sqlite", path); err != nil {
+		t.Fatal(err)
+	}
+
+	target, err := newStore(storeConfig{Backend: "sqlite", DSN: filepath.Join(dir, "target.db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+	// Restore replaces the state of the target.
+	if err := target.SaveOffer(ctx, "offer-2", registeredOffer{Args: args, Price: 99}); err != nil {
+		t.Fatal(err)
+	}
+	backup, err := restoreStore(ctx, target, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if describeDump(backup.State) != "1 watches, 1 offers, 2 searches and 1 fare histories" {
+		t.Fatalf("unexpected backup: %s", describeDump(backup.State))
+	}
+
+	if offer, ok, err := target.Offer(ctx, "offer-1"); err != nil || !ok || offer.Price != 199 || offer.Args.Currency != currency.EUR {
+		t.Fatalf("unexpected restored offer: %+v %v %v", offer, ok, err)
+	}
+	if _, ok, _ := target.Offer(ctx, "offer-2"); ok {
+		t.Fatal("expected the offer of the target to be replaced")
+	}
+	if _, ok, err := target.Search(ctx, kept); err != nil || !ok {
+		t.Fatalf("expected the search to be restored: %v %v", ok, err)
+	}
+	if _, ok, err := target.RestoreSearch(ctx, deleted); err != nil || !ok {
+		t.Fatalf("expected the deleted search to be restored as deleted: %v %v", ok, err)
+	}
+	if points, err := target.ObserveFare(ctx, "fare-1", pricePoint{At: time.Now().Add(time.Hour), Price: 110}); err != nil || len(points) != 2 {
+		t.Fatalf("expected the fare history to be restored: %+v %v", points, err)
+	}
+	if restored, err := target.LoadWatches(ctx); err != nil || len(restored.Watches) != 1 || restored.Watches[0].LastPrice != 240 {
+		t.Fatalf("unexpected restored watches: %+v %v", restored, err)
+	}
+}
+
+func TestRestoreLocked(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	config := storeConfig{Backend: "sqlite", DSN: filepath.Join(dir, "store.db")}
+	serving, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serving.Close()
+	if err := serving.Lock(ctx); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "backup.json")
+	if _, err := backupStore(ctx, serving, "sqlite", path); err != nil {
+		t.Fatal(err)
+	}
+
+	restoring, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := runRestore(restoring, "sqlite", path); code == 0 {
+		t.Fatal("expected no restore under a running server")
+	}
+	restoring.Close()
+
+	if err := os.WriteFile(path, []byte(`{"version": 2}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := restoreStore(ctx, newMemoryStore(""), path); err == nil {
+		t.Fatal("expected an error for a backup of a newer version")
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// callMeta attributes upstream requests to what they're made for. It travels in
+// the context from the tool call or background job into the session layer, where
+// usageMeter accounts, prioritizes and logs every Google request by it.
+type callMeta struct {
+	Client    string // name the MCP client reported, or the background job, e.g. "watches"
+	RequestID string // X-Request-Id of the HTTP request, else generated
+	Tool      string // empty for background jobs
+	Priority  callPriority
+}
+
+type callPriority int
+
+const (
+	priorityInteractive callPriority = iota // a client waits for the result
+	priorityBackground                      // watch checks, pipelines and cache refreshes
+)
+
+func (p callPriority) String() string {
+	if p == priorityBackground {
+		return "background"
+	}
+	return "interactive"
+}
+
+type callMetaKey struct{}
+
+// withCallMeta returns ctx carrying meta.
+func withCallMeta(ctx context.Context, meta callMeta) context.Context {
+	return context.WithValue(ctx, callMetaKey{}, meta)
+}
+
+// callMetaFrom returns the meta of ctx. Requests without one, e.g. of tests or
+// the diagnose subcommand, count as interactive requests of an unknown client.
+func callMetaFrom(ctx context.Context) callMeta {
+	if meta, ok := ctx.Value(callMetaKey{}).(callMeta); ok {
+		return meta
+	}
+	return callMeta{Client: "unknown"}
+}
+
+var lastRequestID atomic.Int64
+
+// newRequestID returns an ID for requests that didn't come with one.
+func newRequestID() string {
+	return "req-" + strconv.FormatInt(lastRequestID.Add(1), 10)
+}
+
+// backgroundContext returns ctx carrying the meta of the background job.
+func backgroundContext(ctx context.Context, job string) context.Context {
+	return withCallMeta(ctx, callMeta{Client: job, RequestID: newRequestID(), Priority: priorityBackground})
+}
+
+// maxClientName is the length client names are cut to.
+const maxClientName = 64
+
+// clientName normalizes the name an MCP client reported, which ends up in logs and
+// metric labels: unprintable characters are dropped and long names cut.
+func clientName(name string) string {
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) {
+			return -1
+		}
+		return r
+	}, name))
+	if len(name) > maxClientName {
+		name = strings.ToValidUTF8(name[:maxClientName], "")
+	}
+	if name == "" {
+		return "unknown"
+	}
+	return name
+}
+
+// attachCallMeta returns a middleware attaching the callMeta of every tool call to
+// its context.
+func attachCallMeta() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			call, ok := req.(*mcp.CallToolRequest)
+			if !ok || call.Params == nil {
+				return next(ctx, method, req)
+			}
+			meta := callMeta{Client: "unknown", Tool: call.Params.Name, Priority: priorityInteractive}
+			if call.Session != nil {
+				if params := call.Session.InitializeParams(); params != nil && params.ClientInfo != nil {
+					meta.Client = clientName(params.ClientInfo.Name)
+				}
+			}
+			if extra := call.Extra; extra != nil && extra.Header != nil {
+				meta.RequestID = extra.Header.Get("X-Request-Id")
+			}
+			if meta.RequestID == "" {
+				meta.RequestID = newRequestID()
+			}
+			return next(withCallMeta(ctx, meta), method, req)
+		}
+	}
+}
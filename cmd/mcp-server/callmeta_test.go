@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestCallMetaReachesSession(t *testing.T) {
+	var metas []callMeta
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			metas = append(metas, callMetaFrom(ctx))
+			return nil, nil
+		},
+	})
+	clientSession := connect(t, s)
+	if _, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{Name: "find_cheapest_offers", Arguments: findCheapestOffersArgs()}); err != nil {
+		t.Fatal(err)
+	}
+	if len(metas) == 0 {
+		t.Fatal("expected upstream requests")
+	}
+	meta := metas[0]
+	if meta.Client != "test-client" || meta.Tool != "find_cheapest_offers" || meta.Priority != priorityInteractive || !strings.HasPrefix(meta.RequestID, "req-") {
+		t.Fatalf("wrong call meta: %+v", meta)
+	}
+
+	s.usage.mu.Lock()
+	used := s.usage.usedBy["test-client"]
+	s.usage.mu.Unlock()
+	if used != len(metas) {
+		t.Fatalf("expected %d requests of test-client, got %d", len(metas), used)
+	}
+}
+
+func TestUsageMeterBackgroundPriority(t *testing.T) {
+	m := newUsageMeter(&fakeSession{}, 5, defaultInteractiveReserve)
+	background := backgroundContext(context.Background(), "watches")
+	for i := 0; i < 4; i++ {
+		if _, err := m.GetPriceGraph(background, flights.PriceGraphArgs{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.GetPriceGraph(background, flights.PriceGraphArgs{}); err == nil {
+		t.Fatal("expected background requests to stop at their share of the quota")
+	}
+	// Clients get the rest.
+	if _, err := m.GetPriceGraph(context.Background(), flights.PriceGraphArgs{}); err != nil {
+		t.Fatal(err)
+	}
+	if m.usedBy["watches"] != 4 || m.usedBy["unknown"] != 1 {
+		t.Fatalf("wrong accounting: %v", m.usedBy)
+	}
+}
+
+func TestUsageMeterBoundsClients(t *testing.T) {
+	m := newUsageMeter(&fakeSession{}, 0, defaultInteractiveReserve)
+	for i := range maxTrackedClients + 5 {
+		ctx := withCallMeta(context.Background(), callMeta{Client: "client-" + strconv.Itoa(i)})
+		if _, err := m.GetPriceGraph(ctx, flights.PriceGraphArgs{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(m.usedBy) != maxTrackedClients+1 || m.usedBy[otherClients] != 5 {
+		t.Fatalf("expected %d clients and 5 other requests, got %d and %d", maxTrackedClients, len(m.usedBy)-1, m.usedBy[otherClients])
+	}
+
+	for name, expected := range map[string]string{
+		"claude-ai":             "claude-ai",
+		" my\nclient\t":         "myclient",
+		"":                      "unknown",
+		"\x00":                  "unknown",
+		strings.Repeat("ä", 40): strings.Repeat("ä", 32),
+		strings.Repeat("a", 65): strings.Repeat("a", 64),
+	} {
+		if got := clientName(name); got != expected {
+			t.Errorf("clientName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
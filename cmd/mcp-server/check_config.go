@@ -0,0 +1,354 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/policy"
+)
+
+// configSources are the settings and files check-config validates besides the
+// plain flags.
+type configSources struct {
+	Store         storeConfig
+	PipelinesFile string
+	TravelPolicy  string
+	WatchInterval time.Duration
+	CookieFile    string
+	Route         policy.Route
+}
+
+type checkConfigResponse struct {
+	Valid     bool              `json:"valid"` // no check failed
+	Checks    []diagnosticCheck `json:"checks"`
+	CheckedAt string            `json:"checkedAt"`
+}
+
+// airportCodePattern matches route policy entries written like airport codes
+// rather than city names.
+var airportCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// checkConfig validates the configuration without starting anything or sending
+// requests to Google, so that a broken deployment is caught before it's rolled out.
+func checkConfig(ctx context.Context, c configSources) checkConfigResponse {
+	response := checkConfigResponse{Valid: true, CheckedAt: time.Now().UTC().Format(time.RFC3339)}
+	st, storeCheck := checkConfigStore(ctx, c.Store)
+	response.Checks = append(response.Checks, storeCheck)
+	response.Checks = append(response.Checks, checkConfigPipelines(c.PipelinesFile, c.WatchInterval)...)
+	response.Checks = append(response.Checks,
+		checkConfigTravelPolicy(c.TravelPolicy),
+		checkRoutePolicy(c.Route),
+		checkConfigWatches(ctx, st, c.Store),
+		checkConfigCookies(c.CookieFile),
+	)
+	if st != nil {
+		st.Close()
+	}
+
+	for _, check := range response.Checks {
+		if check.Status == checkFailed {
+			response.Valid = false
+		}
+	}
+	return response
+}
+
+// checkConfigStore opens the store and checks that it's reachable without creating
+// anything, unlike the server: the database of a sqlite or postgres store has to
+// exist and have the schema of this server. The store is nil if it can't be used,
+// the caller closes it otherwise.
+func checkConfigStore(ctx context.Context, config storeConfig) (store, diagnosticCheck) {
+	if config.Backend == "sqlite" || config.Backend == "postgres" {
+		st, err := checkSQLStore(ctx, config)
+		if err != nil {
+			return nil, diagnosticCheck{"store", checkFailed, err.Error()}
+		}
+		return st, diagnosticCheck{"store", checkOK, fmt.Sprintf("%s backend reachable, schema version %d", config.Backend, st.version)}
+	}
+	st, err := newStore(config)
+	if err != nil {
+		return nil, diagnosticCheck{"store", checkFailed, err.Error()}
+	}
+	s := &server{store: st}
+	check := s.checkStore(ctx)
+	if check.Status == checkOK {
+		check.Detail = config.Backend + " backend " + check.Detail
+	}
+	return st, check
+}
+
+// checkSQLStore opens the database of a sqlite or postgres store without applying
+// migrations and checks that it has all tables of this server.
+func checkSQLStore(ctx context.Context, config storeConfig) (*sqlStore, error) {
+	dsn, err := config.database()
+	if err != nil {
+		return nil, err
+	}
+	if config.Backend == "sqlite" {
+		// Opening a missing SQLite file would create it.
+		path, _, _ := strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("%v; run the server with --migrate-only to create the database", err)
+		}
+	}
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	st, err := openSQLDatabase(config.Backend, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.db.PingContext(ctx); err != nil {
+		st.Close()
+		return nil, fmt.Errorf("connect to the %s store: %v", config.Backend, err)
+	}
+	if st.version, err = st.schemaVersion(ctx); err != nil {
+		st.Close()
+		return nil, fmt.Errorf("the database has no tables of the %s store (%v); run the server with --migrate-only to create them", config.Backend, err)
+	}
+	switch {
+	case st.version < len(migrations):
+		st.Close()
+		return nil, fmt.Errorf("the database has schema version %d and lacks the tables of version %d; run the server with --migrate-only to create them", st.version, len(migrations))
+	case st.version > len(migrations):
+		st.Close()
+		return nil, fmt.Errorf("the database has schema version %d, newer than version %d of this server", st.version, len(migrations))
+	}
+	return st, nil
+}
+
+// checkConfigPipelines validates the pipelines file, the credentials of its
+// notifiers and the schedules of the pipelines and watches.
+func checkConfigPipelines(path string, watchInterval time.Duration) []diagnosticCheck {
+	schedules := diagnosticCheck{"schedules", checkOK, fmt.Sprintf("watches are checked every %s", watchInterval)}
+	if watchInterval <= 0 {
+		schedules = diagnosticCheck{"schedules", checkFailed, "watch interval must be positive"}
+	}
+	if path == "" {
+		return []diagnosticCheck{
+			{"pipelines", checkOK, "no pipelines file"},
+			{"notifiers", checkOK, "no notifiers"},
+			schedules,
+		}
+	}
+
+	pipelines, err := loadPipelines(path)
+	if err != nil {
+		return []diagnosticCheck{
+			{"pipelines", checkFailed, err.Error()},
+			{"notifiers", checkFailed, "not checked, the pipelines file is invalid"},
+			schedules,
+		}
+	}
+
+	notifiers := diagnosticCheck{"notifiers", checkOK, ""}
+	var problems []string
+	count := 0
+	for _, p := range pipelines {
+		for _, n := range p.Notify {
+			count++
+			if err := checkNotifierFormat(n); err != nil {
+				problems = append(problems, fmt.Sprintf("pipeline %q: %v", p.Name, err))
+			}
+		}
+	}
+	notifiers.Detail = fmt.Sprintf("%d notifier(s) well-formed", count)
+	if len(problems) > 0 {
+		notifiers = diagnosticCheck{"notifiers", checkFailed, strings.Join(problems, "; ")}
+	}
+
+	if schedules.Status == checkOK {
+		now := time.Now()
+		for _, p := range pipelines {
+			schedules.Detail += fmt.Sprintf(", pipeline %q runs next at %s", p.Name, p.next(now).Format(time.RFC3339))
+		}
+	}
+	return []diagnosticCheck{
+		{"pipelines", checkOK, fmt.Sprintf("%d pipeline(s)", len(pipelines))},
+		notifiers,
+		schedules,
+	}
+}
+
+// checkNotifierFormat checks the shape of the credentials of a notifier that
+// loadPipelines accepted. It can't tell whether they're valid without posting.
+func checkNotifierFormat(n pipelineNotifier) error {
+	switch n.Type {
+	case "matrix":
+		homeserver, err := resolveSecret(n.Homeserver)
+		if err != nil {
+			return err
+		}
+		if u, err := url.Parse(homeserver); err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("matrix homeserver %q is not an https URL", n.Homeserver)
+		}
+		if !strings.HasPrefix(n.RoomID, "!") || !strings.Contains(n.RoomID, ":") {
+			return fmt.Errorf("matrix roomId %q is not a room ID like !abc:example.org, aliases aren't supported", n.RoomID)
+		}
+	case "slack":
+		webhook, err := resolveSecret(n.WebhookURL)
+		if err != nil {
+			return err
+		}
+		if u, err := url.Parse(webhook); err != nil || u.Scheme != "https" || u.Host != "hooks.slack.com" {
+			return errors.New("slack webhookUrl is not a https://hooks.slack.com/ URL")
+		}
+	}
+	return nil
+}
+
+func checkConfigTravelPolicy(path string) diagnosticCheck {
+	if path == "" {
+		return diagnosticCheck{"travelPolicy", checkOK, "no travel policy"}
+	}
+	if _, err := policy.LoadTravel(path); err != nil {
+		return diagnosticCheck{"travelPolicy", checkFailed, err.Error()}
+	}
+	return diagnosticCheck{"travelPolicy", checkOK, "valid"}
+}
+
+// checkRoutePolicy warns about route policy entries that can't match any search:
+// unknown airport codes and entries both allowed and denied.
+func checkRoutePolicy(r policy.Route) diagnosticCheck {
+	if r.Empty() {
+		return diagnosticCheck{"routePolicy", checkOK, "no restrictions"}
+	}
+	var problems []string
+	for _, list := range [][]string{r.AllowedOrigins, r.DeniedOrigins, r.AllowedDestinations, r.DeniedDestinations} {
+		for _, entry := range list {
+			if airportCodePattern.MatchString(entry) {
+				if err := checkAirportCodes([]string{entry}); err != nil {
+					problems = append(problems, err.Error())
+				}
+			}
+		}
+	}
+	for _, kind := range []struct {
+		name            string
+		allowed, denied []string
+	}{
+		{"origin", r.AllowedOrigins, r.DeniedOrigins},
+		{"destination", r.AllowedDestinations, r.DeniedDestinations},
+	} {
+		for _, entry := range kind.allowed {
+			for _, denied := range kind.denied {
+				if strings.EqualFold(entry, denied) {
+					problems = append(problems, fmt.Sprintf("%s %q is both allowed and denied", kind.name, entry))
+				}
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return diagnosticCheck{"routePolicy", checkWarning, strings.Join(problems, "; ")}
+	}
+	return diagnosticCheck{"routePolicy", checkOK, "valid"}
+}
+
+func checkConfigWatches(ctx context.Context, st store, config storeConfig) diagnosticCheck {
+	if st == nil {
+		return diagnosticCheck{"watches", checkFailed, "not checked, the store is invalid"}
+	}
+	if config.Backend == "memory" && config.WatchFile == "" {
+		return diagnosticCheck{"watches", checkOK, "kept in memory, no watch file"}
+	}
+	l, err := loadWatchList(ctx, st)
+	if err != nil {
+		return diagnosticCheck{"watches", checkFailed, fmt.Sprintf("load watches: %v", err)}
+	}
+	where := "the " + config.Backend + " store"
+	if config.WatchFile != "" {
+		where = config.WatchFile
+	}
+	return diagnosticCheck{"watches", checkOK, fmt.Sprintf("%d watch(es) in %s", len(l.watches), where)}
+}
+
+func checkConfigCookies(path string) diagnosticCheck {
+	if path == "" {
+		return diagnosticCheck{"cookies", checkOK, "no cookie file"}
+	}
+	if _, err := flights.NewCookieJar(path); err != nil {
+		return diagnosticCheck{"cookies", checkFailed, fmt.Sprintf("load cookies: %v", err)}
+	}
+	return diagnosticCheck{"cookies", checkOK, "readable"}
+}
+
+// checkFlags runs the parsing main does on the plain flags, reporting all errors
+// instead of stopping at the first one.
+func checkFlags() diagnosticCheck {
+	var problems []string
+	report := func(name string, err error) {
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+	report("transport", checkTransport(*transport))
+	report("price rounding", checkPriceRounding(*priceRounding))
+	_, err := parseFeatures(*featuresFlag)
+	report("features", err)
+	_, err = parseMarket(*market)
+	report("market", err)
+	_, err = parseCityLanguages(*cityLanguages)
+	report("city languages", err)
+	report("schema version", checkSchemaVersion(*schemaVersion))
+	_, err = loadToolTexts(*toolLanguage)
+	report("tool language", err)
+	_, err = parseToolAliases(*toolAliasesFlag)
+	report("tool aliases", err)
+	for _, secret := range []struct {
+		name  string
+		value string
+	}{
+		{"admin token", *adminToken},
+		{"auth token", *authToken},
+		{"consent cookies", *consentCookies},
+		{"telemetry endpoint", *telemetryEndpoint},
+	} {
+		_, err := resolveSecret(secret.value)
+		report(secret.name, err)
+	}
+	if *maxConcurrency <= 0 {
+		problems = append(problems, "max concurrency must be positive")
+	}
+	if *searchRetries < 0 {
+		problems = append(problems, "search retries must not be negative")
+	}
+	if *maxSearchGoroutines < 0 || *maxResultMemoryMB < 0 {
+		problems = append(problems, "max search goroutines and max result memory must not be negative")
+	}
+	if len(problems) > 0 {
+		return diagnosticCheck{"flags", checkFailed, strings.Join(problems, "; ")}
+	}
+	return diagnosticCheck{"flags", checkOK, "valid"}
+}
+
+// runCheckConfig implements the check-config subcommand. It writes the report in
+// the given output format and returns the exit code.
+func runCheckConfig(c configSources, output string) int {
+	response := checkConfig(context.Background(), c)
+	response.Checks = append([]diagnosticCheck{checkFlags()}, response.Checks...)
+	if response.Checks[0].Status == checkFailed {
+		response.Valid = false
+	}
+
+	rows := make([][]string, 0, len(response.Checks))
+	for _, c := range response.Checks {
+		rows = append(rows, []string{c.Name, c.Status, c.Detail})
+	}
+	if err := writeOutput(os.Stdout, output, response, []string{"name", "status", "detail"}, rows); err != nil {
+		log.Printf("write report: %v", err)
+		return 1
+	}
+	if !response.Valid {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/policy"
+)
+
+func TestCheckConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	checks := func(response checkConfigResponse) map[string]diagnosticCheck {
+		byName := map[string]diagnosticCheck{}
+		for _, c := range response.Checks {
+			byName[c.Name] = c
+		}
+		return byName
+	}
+
+	response := checkConfig(context.Background(), configSources{Store: storeConfig{Backend: "memory"}, WatchInterval: time.Hour})
+	if !response.Valid {
+		t.Fatalf("expected the defaults to be valid, got %+v", response.Checks)
+	}
+
+	response = checkConfig(context.Background(), configSources{
+		Store: storeConfig{Backend: "memory", WatchFile: write("watches.json", `{"watches": [`)},
+		PipelinesFile: write("pipelines.json", `{"pipelines": [{
+			"name": "weekly", "weekday": "sunday", "time": "08:30",
+			"searches": [{"name": "nyc", "params": {"srcCities": ["San Francisco"], "dstCities": ["New York"], "tripLengths": [7]}}],
+			"notify": [
+				{"type": "slack", "webhookUrl": "https://example.com/hook"},
+				{"type": "matrix", "homeserver": "https://matrix.org", "roomId": "!abc:matrix.org", "accessToken": "token"}
+			]
+		}]}`),
+		TravelPolicy:  write("policy.json", `{"maxPrice": 500}`),
+		WatchInterval: time.Hour,
+		Route:         policy.Route{AllowedOrigins: []string{"SFO", "XXQ"}, DeniedOrigins: []string{"sfo"}},
+	})
+	if response.Valid {
+		t.Fatal("expected an invalid config")
+	}
+	byName := checks(response)
+	if c := byName["pipelines"]; c.Status != checkOK {
+		t.Fatalf("expected the pipelines to load, got %+v", c)
+	}
+	if c := byName["notifiers"]; c.Status != checkFailed || !strings.Contains(c.Detail, "slack webhookUrl") || strings.Contains(c.Detail, "matrix") {
+		t.Fatalf("expected the slack webhook only to fail, got %+v", c)
+	}
+	if c := byName["schedules"]; c.Status != checkOK || !strings.Contains(c.Detail, `pipeline "weekly" runs next at`) {
+		t.Fatalf("expected the next run of the pipeline, got %+v", c)
+	}
+	if c := byName["travelPolicy"]; c.Status != checkFailed || !strings.Contains(c.Detail, "currency is required") {
+		t.Fatalf("expected the travel policy to fail, got %+v", c)
+	}
+	if c := byName["routePolicy"]; c.Status != checkWarning || !strings.Contains(c.Detail, `"XXQ"`) || !strings.Contains(c.Detail, `origin "SFO" is both allowed and denied`) {
+		t.Fatalf("expected warnings about the route policy, got %+v", c)
+	}
+	if c := byName["watches"]; c.Status != checkFailed {
+		t.Fatalf("expected the watch file to fail, got %+v", c)
+	}
+
+	response = checkConfig(context.Background(), configSources{Store: storeConfig{Backend: "redis"}, PipelinesFile: write("broken.json", `{}x`)})
+	byName = checks(response)
+	if byName["store"].Status != checkFailed || byName["pipelines"].Status != checkFailed || byName["schedules"].Status != checkFailed {
+		t.Fatalf("expected the store, pipelines and schedules to fail, got %+v", response.Checks)
+	}
+}
+
+func TestCheckConfigSQLStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "store.db")
+	config := storeConfig{Backend: "sqlite", DSN: path}
+
+	// The database isn't created.
+	if st, check := checkConfigStore(ctx, config); st != nil || check.Status != checkFailed || !strings.Contains(check.Detail, "--migrate-only") {
+		t.Fatalf("expected a missing database to fail, got %+v", check)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Fatal("expected check-config not to create the database")
+	}
+
+	// Neither are its tables.
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE unrelated (id INTEGER)`); err != nil {
+		t.Fatal(err)
+	}
+	response := checkConfig(ctx, configSources{Store: config, WatchInterval: time.Hour})
+	if response.Valid || response.Checks[0].Status != checkFailed || !strings.Contains(response.Checks[0].Detail, "no tables") {
+		t.Fatalf("expected a database without tables to fail, got %+v", response.Checks)
+	}
+	var tables int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table'`).Scan(&tables); err != nil || tables != 1 {
+		t.Fatalf("expected check-config not to create tables, got %d tables: %v", tables, err)
+	}
+
+	st, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.Close()
+	if response := checkConfig(ctx, configSources{Store: config, WatchInterval: time.Hour}); !response.Valid {
+		t.Fatalf("expected the migrated database to be valid, got %+v", response.Checks)
+	}
+}
+
+func TestCheckNotifierFormatResolvesSecrets(t *testing.T) {
+	t.Setenv("MATRIX_HOMESERVER", "https://matrix.org")
+	n := pipelineNotifier{Type: "matrix", Homeserver: "env:MATRIX_HOMESERVER", RoomID: "!abc:matrix.org", AccessToken: "token"}
+	if err := checkNotifierFormat(n); err != nil {
+		t.Fatalf("expected the resolved homeserver to be well-formed, got %v", err)
+	}
+
+	t.Setenv("MATRIX_HOMESERVER", "http://matrix.org")
+	if err := checkNotifierFormat(n); err == nil || !strings.Contains(err.Error(), "not an https URL") {
+		t.Fatalf("expected the resolved homeserver to be rejected, got %v", err)
+	}
+	n.Homeserver = "env:MATRIX_HOMESERVER_UNSET"
+	if err := checkNotifierFormat(n); err == nil {
+		t.Fatal("expected an error for an unset homeserver secret")
+	}
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// stdioServerEnv makes the test binary serve the conformance backend on stdio
+// instead of running the tests, so that the conformance suite can start the server
+// as a process like MCP clients do.
+const stdioServerEnv = "FLIGHTS_MCP_CONFORMANCE_STDIO"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(stdioServerEnv) != "" {
+		progressInterval = time.Millisecond
+		s, err := newOfflineServer(conformanceBackend())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := s.serveStdio(context.Background()); err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// conformanceBackend serves four dates of offers, slowly enough for progress
+// notifications. Searches from the city "Blocked" wait until they're canceled.
+func conformanceBackend() *fakeSession {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	return &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			if len(args.SrcCities) > 0 && args.SrcCities[0] == "Blocked" {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			var graph []flights.Offer
+			for i := range 4 {
+				date := start.AddDate(0, 0, i)
+				graph = append(graph, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: 100})
+			}
+			return graph, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			time.Sleep(10 * time.Millisecond)
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+}
+
+// TestConformance runs the MCP flows clients rely on over every transport against
+// the offline backend.
+func TestConformance(t *testing.T) {
+	interval := progressInterval
+	progressInterval = time.Millisecond
+	t.Cleanup(func() { progressInterval = interval })
+
+	httpTransport := func(transport string, client func(url string) mcp.Transport) func(t *testing.T) mcp.Transport {
+		return func(t *testing.T) mcp.Transport {
+			s, err := newOfflineServer(conformanceBackend())
+			if err != nil {
+				t.Fatal(err)
+			}
+			connCtx, closeConns := context.WithCancel(context.Background())
+			srv := httptest.NewServer(s.mcpHandler(connCtx, transport))
+			t.Cleanup(func() {
+				closeConns()
+				srv.Close()
+			})
+			return client(srv.URL)
+		}
+	}
+	for _, tc := range []struct {
+		transport string
+		connect   func(t *testing.T) mcp.Transport
+	}{
+		{"stdio", func(t *testing.T) mcp.Transport {
+			cmd := exec.Command(os.Args[0])
+			cmd.Env = append(os.Environ(), stdioServerEnv+"=1")
+			cmd.Stderr = os.Stderr
+			return &mcp.CommandTransport{Command: cmd}
+		}},
+		{"sse", httpTransport("sse", func(url string) mcp.Transport {
+			return &mcp.SSEClientTransport{Endpoint: url}
+		})},
+		{"streamable-http", httpTransport("streamable-http", func(url string) mcp.Transport {
+			return &mcp.StreamableClientTransport{Endpoint: url}
+		})},
+	} {
+		t.Run(tc.transport, func(t *testing.T) {
+			var (
+				mu            sync.Mutex
+				notifications []*mcp.ProgressNotificationParams
+			)
+			client := mcp.NewClient(&mcp.Implementation{Name: "conformance", Version: "0.0.1"}, &mcp.ClientOptions{
+				ProgressNotificationHandler: func(ctx context.Context, req *mcp.ProgressNotificationClientRequest) {
+					mu.Lock()
+					defer mu.Unlock()
+					notifications = append(notifications, req.Params)
+				},
+			})
+			clientSession, err := client.Connect(context.Background(), tc.connect(t), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer clientSession.Close()
+
+			checkInitialize(t, clientSession)
+			schemas := checkToolList(t, clientSession)
+			checkToolCall(t, clientSession, schemas)
+			checkProgress(t, clientSession, schemas, func() []*mcp.ProgressNotificationParams {
+				mu.Lock()
+				defer mu.Unlock()
+				return notifications
+			})
+			checkCancel(t, clientSession)
+			checkUnknownTool(t, clientSession)
+		})
+	}
+}
+
+func checkInitialize(t *testing.T, clientSession *mcp.ClientSession) {
+	t.Helper()
+	init := clientSession.InitializeResult()
+	if init == nil || init.ProtocolVersion == "" {
+		t.Fatalf("initialize: missing protocol version: %+v", init)
+	}
+	if init.ServerInfo == nil || init.ServerInfo.Name != serverName {
+		t.Fatalf("initialize: wrong server info: %+v", init.ServerInfo)
+	}
+	if init.Capabilities == nil || init.Capabilities.Tools == nil {
+		t.Fatalf("initialize: the server doesn't announce tools: %+v", init.Capabilities)
+	}
+}
+
+// checkToolList checks the listed tools and returns their resolved output schemas
+// by name.
+func checkToolList(t *testing.T, clientSession *mcp.ClientSession) map[string]*jsonschema.Resolved {
+	t.Helper()
+	schemas := map[string]*jsonschema.Resolved{}
+	for tool, err := range clientSession.Tools(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("tools/list: %v", err)
+		}
+		if _, ok := schemas[tool.Name]; ok {
+			t.Fatalf("tools/list: %s is listed twice", tool.Name)
+		}
+		if tool.Description == "" {
+			t.Errorf("tools/list: %s has no description", tool.Name)
+		}
+		if _, err := resolveSchema(tool.InputSchema); err != nil {
+			t.Errorf("tools/list: input schema of %s: %v", tool.Name, err)
+		}
+		output, err := resolveSchema(tool.OutputSchema)
+		if err != nil {
+			t.Errorf("tools/list: output schema of %s: %v", tool.Name, err)
+		}
+		schemas[tool.Name] = output
+	}
+	for _, name := range []string{"find_cheapest_offers", "server_info"} {
+		if schemas[name] == nil {
+			t.Fatalf("tools/list: %s is missing", name)
+		}
+	}
+	return schemas
+}
+
+// resolveSchema resolves a schema of the tool list, which has to describe an object.
+func resolveSchema(schema any) (*jsonschema.Resolved, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	var s jsonschema.Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if s.Type != "object" {
+		return nil, fmt.Errorf("schema of type %q, expected an object", s.Type)
+	}
+	return s.Resolve(nil)
+}
+
+// callTool calls a tool, which has to succeed with structured content matching its
+// output schema.
+func callTool(t *testing.T, clientSession *mcp.ClientSession, schemas map[string]*jsonschema.Resolved, params *mcp.CallToolParams) map[string]any {
+	t.Helper()
+	result, err := clientSession.CallTool(context.Background(), params)
+	if err != nil {
+		t.Fatalf("tools/call %s: %v", params.Name, err)
+	}
+	if result.IsError || len(result.Content) == 0 {
+		t.Fatalf("tools/call %s: unexpected result: %+v", params.Name, result.Content)
+	}
+	structured, ok := result.StructuredContent.(map[string]any)
+	if !ok {
+		t.Fatalf("tools/call %s: unexpected structured content: %#v", params.Name, result.StructuredContent)
+	}
+	if err := schemas[params.Name].Validate(structured); err != nil {
+		t.Fatalf("tools/call %s: structured content doesn't match the output schema: %v", params.Name, err)
+	}
+	return structured
+}
+
+func checkToolCall(t *testing.T, clientSession *mcp.ClientSession, schemas map[string]*jsonschema.Resolved) {
+	t.Helper()
+	info := callTool(t, clientSession, schemas, &mcp.CallToolParams{Name: "server_info", Arguments: map[string]any{}})
+	if info["schemaVersion"] != float64(currentSchemaVersion) {
+		t.Fatalf("tools/call server_info: wrong schema version: %v", info["schemaVersion"])
+	}
+}
+
+func checkProgress(t *testing.T, clientSession *mcp.ClientSession, schemas map[string]*jsonschema.Resolved, notifications func() []*mcp.ProgressNotificationParams) {
+	t.Helper()
+	// SetProgressToken only works on params that already have metadata.
+	params := &mcp.CallToolParams{Meta: mcp.Meta{}, Name: "find_cheapest_offers", Arguments: findCheapestOffersArgs()}
+	params.SetProgressToken("conformance")
+	response := callTool(t, clientSession, schemas, params)
+	if offers, _ := response["offers"].([]any); len(offers) == 0 {
+		t.Fatalf("tools/call find_cheapest_offers: expected offers, got %v", response)
+	}
+
+	// Notifications may arrive after the response on HTTP transports.
+	deadline := time.Now().Add(5 * time.Second)
+	for len(notifications()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	received := notifications()
+	if len(received) == 0 {
+		t.Fatal("progress: expected progress notifications")
+	}
+	last := -1.0
+	for _, n := range received {
+		if n.ProgressToken != "conformance" {
+			t.Fatalf("progress: wrong progress token: %v", n.ProgressToken)
+		}
+		if n.Progress <= last {
+			t.Fatalf("progress: progress didn't increase: %v after %v", n.Progress, last)
+		}
+		last = n.Progress
+	}
+}
+
+func checkCancel(t *testing.T, clientSession *mcp.ClientSession) {
+	t.Helper()
+	args := findCheapestOffersArgs()
+	args["srcCities"] = []string{"Blocked"}
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		_, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: "find_cheapest_offers", Arguments: args})
+		errc <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Fatal("cancel: expected the canceled call to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cancel: the canceled call didn't return")
+	}
+	// The session outlives the canceled call.
+	if _, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{Name: "server_info", Arguments: map[string]any{}}); err != nil {
+		t.Fatalf("cancel: the session failed after the cancellation: %v", err)
+	}
+}
+
+func checkUnknownTool(t *testing.T, clientSession *mcp.ClientSession) {
+	t.Helper()
+	result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{Name: "no_such_tool", Arguments: map[string]any{}})
+	if err == nil && !result.IsError {
+		t.Fatal("tools/call: expected an error for an unknown tool")
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// googleHomeURL is requested by diagnose to check whether Google can be reached.
+const googleHomeURL = "https://www.google.com/"
+
+// maxClockSkew is the clock difference to Google that diagnose tolerates.
+const maxClockSkew = time.Minute
+
+const (
+	checkOK      = "ok"
+	checkWarning = "warning"
+	checkFailed  = "failed"
+)
+
+type diagnoseParams struct{}
+
+type diagnosticCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // ok, warning or failed
+	Detail string `json:"detail"`
+}
+
+type diagnoseResponse struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Healthy       bool              `json:"healthy"` // no check failed
+	Checks        []diagnosticCheck `json:"checks"`
+	CheckedAt     string            `json:"checkedAt"`
+}
+
+func (s *server) diagnoseTool(ctx context.Context, _ *mcp.CallToolRequest, _ diagnoseParams) (*mcp.CallToolResult, diagnoseResponse, error) {
+	response := s.diagnose(ctx)
+
+	var summary strings.Builder
+	if response.Healthy {
+		summary.WriteString("All checks passed.")
+	} else {
+		summary.WriteString("Some checks failed.")
+	}
+	for _, c := range response.Checks {
+		summary.WriteString(fmt.Sprintf("\n%s: %s, %s", c.Name, c.Status, c.Detail))
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+// diagnose checks the parts a search depends on and explains what's wrong with
+// the deployment when searches fail or come back empty.
+func (s *server) diagnose(ctx context.Context) diagnoseResponse {
+	response := diagnoseResponse{
+		SchemaVersion: s.schemaVersion,
+		Healthy:       true,
+		CheckedAt:     time.Now().UTC().Format(time.RFC3339),
+	}
+	if s.warm != nil {
+		response.Checks = append(response.Checks, s.checkWarmUp())
+	}
+	response.Checks = append(response.Checks, s.checkGoogle(ctx)...)
+	response.Checks = append(response.Checks, s.checkQuota(), s.checkStore(ctx))
+
+	for _, c := range response.Checks {
+		if c.Status == checkFailed {
+			response.Healthy = false
+		}
+	}
+	return response
+}
+
+func (s *server) checkWarmUp() diagnosticCheck {
+	status := s.warm.status()
+	if status.Status == warmUpReady {
+		return diagnosticCheck{"session", checkOK, "ready since " + status.ReadySince}
+	}
+	detail := fmt.Sprintf("still warming up after %d attempt(s)", status.Attempts)
+	if status.LastError != "" {
+		detail += ", last error: " + status.LastError
+	}
+	return diagnosticCheck{"session", checkFailed, detail}
+}
+
+// checkGoogle requests Google's home page with the session cookies and reports
+// whether Google is reachable, rate limits the server, asks for consent, and how
+// far the local clock is off.
+func (s *server) checkGoogle(ctx context.Context) []diagnosticCheck {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.googleURL, nil)
+	if err != nil {
+		return []diagnosticCheck{{"google", checkFailed, err.Error()}}
+	}
+	var cookies []string
+	if s.cookies != nil {
+		cookies = s.cookies.Cookies()
+	}
+	if cookies = append(cookies, s.consentCookies...); len(cookies) > 0 {
+		req.Header.Set("Cookie", strings.Join(cookies, "; "))
+	}
+	client := &http.Client{
+		// The consent interstitial is a redirect, look at it instead of following it.
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return []diagnosticCheck{{"google", checkFailed, "Google is unreachable: " + err.Error()}}
+	}
+	resp.Body.Close()
+
+	checks := []diagnosticCheck{{"google", checkOK, fmt.Sprintf("reachable, status %d", resp.StatusCode)}}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		checks = append(checks, diagnosticCheck{"rateLimit", checkFailed, "Google rate limits this server's IP address, searches fail until it lifts the limit"})
+	case resp.StatusCode >= 500:
+		checks[0] = diagnosticCheck{"google", checkFailed, fmt.Sprintf("Google responded with status %d", resp.StatusCode)}
+	default:
+		checks = append(checks, diagnosticCheck{"rateLimit", checkOK, "not rate limited by Google"})
+	}
+
+	if location := resp.Header.Get("Location"); strings.Contains(location, "consent.") {
+		checks = append(checks, diagnosticCheck{"consent", checkFailed, "Google redirects to its consent page, which makes searches come back empty; set --consent-cookies"})
+	} else {
+		checks = append(checks, diagnosticCheck{"consent", checkOK, "no consent page"})
+	}
+
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		// The Date header has a resolution of one second.
+		skew := time.Since(date).Round(time.Second)
+		check := diagnosticCheck{"clock", checkOK, fmt.Sprintf("%s off Google's clock", skew)}
+		if skew > maxClockSkew || skew < -maxClockSkew {
+			check.Status = checkWarning
+			check.Detail += ", dates near midnight may shift; sync the clock"
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+func (s *server) checkQuota() diagnosticCheck {
+	m := s.usage
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rollOver()
+
+	if m.dailyQuota == 0 {
+		return diagnosticCheck{"quota", checkOK, fmt.Sprintf("%d upstream requests used today, no daily quota", m.used)}
+	}
+	check := diagnosticCheck{"quota", checkOK, fmt.Sprintf("%d of %d upstream requests used today", m.used, m.dailyQuota)}
+	switch {
+	case m.used >= m.dailyQuota:
+		check.Status = checkFailed
+		check.Detail += ", searches fail until " + m.day.Add(24*time.Hour).Format(time.RFC3339)
+	case m.used*10 >= m.dailyQuota*9:
+		check.Status = checkWarning
+	}
+	return check
+}
+
+func (s *server) checkStore(ctx context.Context) diagnosticCheck {
+	if _, _, err := s.store.Search(ctx, "diagnose"); err != nil {
+		return diagnosticCheck{"store", checkFailed, err.Error()}
+	}
+	return diagnosticCheck{"store", checkOK, "reachable"}
+}
+
+// runDiagnose implements the diagnose subcommand. It waits up to timeout for the
+// warm-up, writes the report in the given output format and returns the exit code.
+func runDiagnose(s *server, timeout time.Duration, output string) int {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	s.warm.Run(ctx)
+
+	response := s.diagnose(context.Background())
+	rows := make([][]string, 0, len(response.Checks))
+	for _, c := range response.Checks {
+		rows = append(rows, []string{c.Name, c.Status, c.Detail})
+	}
+	if err := writeOutput(os.Stdout, output, response, []string{"name", "status", "detail"}, rows); err != nil {
+		log.Printf("write report: %v", err)
+		return 1
+	}
+	if !response.Healthy {
+		return 1
+	}
+	return 0
+}
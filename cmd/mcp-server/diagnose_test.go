@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDiagnose(t *testing.T) {
+	var cookie string
+	google := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie = r.Header.Get("Cookie")
+		w.Header().Set("Date", time.Now().Add(-5*time.Minute).UTC().Format(http.TimeFormat))
+		http.Redirect(w, r, "https://consent.google.com/ml?continue=https://www.google.com/", http.StatusFound)
+	}))
+	defer google.Close()
+
+	s := newTestServer(t, &fakeSession{})
+	s.googleURL = google.URL
+	s.consentCookies = []string{"SOCS=abc"}
+	s.usage.dailyQuota = 10
+	for range 9 {
+		if err := s.usage.acquire(context.Background(), callMeta{}); err != nil {
+			t.Fatal(err)
+		}
+		s.usage.release(callMeta{})
+	}
+
+	response := s.diagnose(context.Background())
+	if response.Healthy {
+		t.Fatal("a consent redirect should make the report unhealthy")
+	}
+	if cookie != "SOCS=abc" {
+		t.Fatalf("the consent cookies weren't sent: %q", cookie)
+	}
+
+	statuses := map[string]string{}
+	for _, c := range response.Checks {
+		statuses[c.Name] = c.Status
+	}
+	expected := map[string]string{
+		"google":    checkOK,
+		"rateLimit": checkOK,
+		"consent":   checkFailed,
+		"clock":     checkWarning,
+		"quota":     checkWarning,
+		"store":     checkOK,
+	}
+	for name, status := range expected {
+		if statuses[name] != status {
+			t.Errorf("%s: expected %s, got %q", name, status, statuses[name])
+		}
+	}
+}
+
+func TestDiagnoseGoogleUnreachable(t *testing.T) {
+	google := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	google.Close()
+
+	s := newTestServer(t, &fakeSession{})
+	s.googleURL = google.URL
+	response := s.diagnose(context.Background())
+	if response.Healthy || response.Checks[0].Name != "google" || response.Checks[0].Status != checkFailed {
+		t.Fatalf("expected a failed google check: %+v", response.Checks)
+	}
+}
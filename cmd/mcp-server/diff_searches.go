@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type diffSearchesParams struct {
+	BaseSearchID string `json:"baseSearchId" jsonschema:"searchId of the older search"`
+	SearchID     string `json:"searchId" jsonschema:"searchId of the newer search"`
+}
+
+type priceChangeResponse struct {
+	Offer         offerResponse `json:"offer"` // offer from the newer search
+	PreviousPrice float64       `json:"previousPrice"`
+	Change        float64       `json:"change"` // new price minus previous price
+}
+
+type diffSearchesResponse struct {
+	SchemaVersion     int                   `json:"schemaVersion"`
+	NewOffers         []offerResponse       `json:"newOffers"`         // offers only found by the newer search
+	DisappearedOffers []offerResponse       `json:"disappearedOffers"` // offers only found by the older search
+	PriceChanges      []priceChangeResponse `json:"priceChanges"`      // offers found by both searches with a different price
+	Unchanged         int                   `json:"unchanged"`
+}
+
+func (s *server) diffSearches(ctx context.Context, _ *mcp.CallToolRequest, params diffSearchesParams) (*mcp.CallToolResult, diffSearchesResponse, error) {
+	base, ok, err := s.store.Search(ctx, params.BaseSearchID)
+	if err != nil {
+		return nil, diffSearchesResponse{}, fmt.Errorf("load search: %w", err)
+	}
+	if !ok {
+		return nil, diffSearchesResponse{}, fmt.Errorf("unknown baseSearchId %q, it may have expired", params.BaseSearchID)
+	}
+	current, ok, err := s.store.Search(ctx, params.SearchID)
+	if err != nil {
+		return nil, diffSearchesResponse{}, fmt.Errorf("load search: %w", err)
+	}
+	if !ok {
+		return nil, diffSearchesResponse{}, fmt.Errorf("unknown searchId %q, it may have expired", params.SearchID)
+	}
+
+	response := diffOffers(base.Offers, current.Offers)
+	response.SchemaVersion = s.schemaVersion
+
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("%d new, %d disappeared, %d price change(s), %d unchanged.",
+		len(response.NewOffers), len(response.DisappearedOffers), len(response.PriceChanges), response.Unchanged))
+	for _, c := range response.PriceChanges {
+		summary.WriteString(fmt.Sprintf("\n%s -> %s on %s: %s -> %s %s",
+			c.Offer.SrcAirport, c.Offer.DstAirport, c.Offer.StartDate, s.formatPrice(c.PreviousPrice), s.formatPrice(c.Offer.Price), c.Offer.Currency))
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+// diffOffers compares two result sets itinerary by itinerary using the offer IDs.
+// The offers are reported as remembered, with their original fetch time.
+func diffOffers(base, current []offerResponse) diffSearchesResponse {
+	base, current = asHistory(base), asHistory(current)
+
+	response := diffSearchesResponse{
+		NewOffers:         []offerResponse{},
+		DisappearedOffers: []offerResponse{},
+		PriceChanges:      []priceChangeResponse{},
+	}
+
+	baseByID := make(map[string]offerResponse, len(base))
+	for _, o := range base {
+		baseByID[o.ID] = o
+	}
+	currentIDs := make(map[string]bool, len(current))
+
+	for _, o := range current {
+		currentIDs[o.ID] = true
+		previous, ok := baseByID[o.ID]
+		switch {
+		case !ok:
+			response.NewOffers = append(response.NewOffers, o)
+		case previous.Price != o.Price:
+			response.PriceChanges = append(response.PriceChanges, priceChangeResponse{
+				Offer:         o,
+				PreviousPrice: previous.Price,
+				Change:        o.Price - previous.Price,
+			})
+		default:
+			response.Unchanged++
+		}
+	}
+	for _, o := range base {
+		if !currentIDs[o.ID] {
+			response.DisappearedOffers = append(response.DisappearedOffers, o)
+		}
+	}
+
+	sort.SliceStable(response.PriceChanges, func(i, j int) bool {
+		return response.PriceChanges[i].Change < response.PriceChanges[j].Change
+	})
+	return response
+}
+
+// asHistory returns a copy of offers marked as remembered.
+func asHistory(offers []offerResponse) []offerResponse {
+	history := make([]offerResponse, len(offers))
+	for i, o := range offers {
+		o.Source = sourceHistory
+		history[i] = o
+	}
+	return history
+}
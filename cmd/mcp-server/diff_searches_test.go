@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestDiffOffers(t *testing.T) {
+	base := []offerResponse{
+		{ID: "a", Price: 100, Source: sourceLive, FetchedAt: "2026-01-01T10:00:00Z"},
+		{ID: "b", Price: 200, Source: sourceLive, FetchedAt: "2026-01-01T10:00:00Z"},
+	}
+	current := []offerResponse{
+		{ID: "a", Price: 90, Source: sourceLive, FetchedAt: "2026-01-02T10:00:00Z"},
+		{ID: "c", Price: 300, Source: sourceLive, FetchedAt: "2026-01-02T10:00:00Z"},
+	}
+
+	diff := diffOffers(base, current)
+	if len(diff.NewOffers) != 1 || diff.NewOffers[0].ID != "c" {
+		t.Fatalf("wrong new offers: %+v", diff.NewOffers)
+	}
+	if len(diff.DisappearedOffers) != 1 || diff.DisappearedOffers[0].ID != "b" {
+		t.Fatalf("wrong disappeared offers: %+v", diff.DisappearedOffers)
+	}
+	if len(diff.PriceChanges) != 1 || diff.PriceChanges[0].Change != -10 {
+		t.Fatalf("wrong price changes: %+v", diff.PriceChanges)
+	}
+
+	// Remembered offers keep their fetch time but aren't reported as live.
+	if o := diff.PriceChanges[0].Offer; o.Source != sourceHistory || o.FetchedAt != "2026-01-02T10:00:00Z" {
+		t.Fatalf("wrong source or fetch time: %+v", o)
+	}
+	if base[0].Source != sourceLive {
+		t.Fatal("the stored offers must not be modified")
+	}
+}
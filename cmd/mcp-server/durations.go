@@ -0,0 +1,29 @@
+package main
+
+import "time"
+
+// deadlineMargin is the part of a client's deadlineSeconds left for building
+// and sending the response.
+const deadlineMargin = time.Second
+
+// durationHint is the expected duration of a tool call, published in the _meta
+// of the tool so that clients can pick their timeouts.
+type durationHint struct {
+	TypicalSeconds int    `json:"typicalSeconds"`
+	MaxSeconds     int    `json:"maxSeconds"`
+	DeadlineParam  string `json:"deadlineParam,omitempty"` // parameter bounding the duration of a call
+}
+
+// toolDurations are the duration hints of the tools sending upstream requests.
+// The other tools answer from memory right away.
+var toolDurations = map[string]durationHint{
+	"find_cheapest_offers":  {TypicalSeconds: 20, MaxSeconds: 180, DeadlineParam: "deadlineSeconds"},
+	"search_flights":        {TypicalSeconds: 3, MaxSeconds: 20},
+	"price_by_airline":      {TypicalSeconds: 15, MaxSeconds: 90},
+	"price_by_weekday":      {TypicalSeconds: 2, MaxSeconds: 15},
+	"get_price_calendar":    {TypicalSeconds: 4, MaxSeconds: 30},
+	"recommend_trip_length": {TypicalSeconds: 15, MaxSeconds: 90},
+	"recheck_offer":         {TypicalSeconds: 3, MaxSeconds: 20},
+	"prepare_booking":       {TypicalSeconds: 3, MaxSeconds: 20},
+	"diagnose":              {TypicalSeconds: 2, MaxSeconds: 10},
+}
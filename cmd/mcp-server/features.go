@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// feature describes an experimental subsystem that operators opt into per deployment.
+type feature struct {
+	Name        string
+	Description string
+}
+
+// Feature names accepted by --features.
+const (
+	// featureFastMode verifies the dates of find_cheapest_offers with one request
+	// each, see cheapoffers.Args.ShallowVerification.
+	featureFastMode = "fast-mode"
+)
+
+// knownFeatures lists every feature flag accepted by --features. Experimental
+// subsystems check [featureSet.Enabled] before using their experimental behavior.
+var knownFeatures = []feature{
+	{Name: featureFastMode, Description: "Trade verification depth for latency in cheap offer searches: judge each date by the price range of the searched cities instead of a second search of its best airport pair."},
+}
+
+// featureSet holds the enabled state of every known feature.
+type featureSet map[string]bool
+
+// parseFeatures parses a comma-separated list of feature names. Unknown names are
+// rejected so that typos in deployment configs don't go unnoticed.
+func parseFeatures(list string) (featureSet, error) {
+	set := featureSet{}
+	for _, f := range knownFeatures {
+		set[f.Name] = false
+	}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := set[name]; !ok {
+			return nil, fmt.Errorf("unknown feature %q", name)
+		}
+		set[name] = true
+	}
+	return set, nil
+}
+
+// Enabled reports whether the named feature is turned on.
+func (s featureSet) Enabled(name string) bool {
+	return s[name]
+}
+
+// Names returns the enabled feature names in alphabetical order.
+func (s featureSet) Names() []string {
+	names := []string{}
+	for name, enabled := range s {
+		if enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
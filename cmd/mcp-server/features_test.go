@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestParseFeatures(t *testing.T) {
+	features, err := parseFeatures(" fast-mode ,,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !features.Enabled(featureFastMode) || !reflect.DeepEqual(features.Names(), []string{featureFastMode}) {
+		t.Fatalf("expected fast-mode to be enabled, got %v", features)
+	}
+
+	features, err = parseFeatures("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if features.Enabled(featureFastMode) || len(features.Names()) != 0 {
+		t.Fatalf("expected no enabled features, got %v", features)
+	}
+
+	if _, err := parseFeatures("fast-mode,fast-mod"); err == nil {
+		t.Fatal("expected an error for an unknown feature")
+	}
+}
+
+func TestFastModeSearchesDatesOnce(t *testing.T) {
+	start := time.Now().AddDate(0, 1, 0)
+	var pairSearches atomic.Int64
+	session := &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: 100}}, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			if len(args.SrcCities) == 0 {
+				pairSearches.Add(1)
+			}
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 90},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 120, High: 200}, nil
+		},
+	}
+	params := findCheapestOffersParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		TripLengths:    []int{7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+	}
+
+	for _, tc := range []struct {
+		features     string
+		pairSearches int64
+	}{
+		{"", 1},
+		{featureFastMode, 0},
+	} {
+		pairSearches.Store(0)
+		s := newTestServer(t, session)
+		var err error
+		if s.features, err = parseFeatures(tc.features); err != nil {
+			t.Fatal(err)
+		}
+		_, response, err := s.findCheapestOffers(context.Background(), nil, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Offers) != 1 {
+			t.Fatalf("features %q: expected an offer, got %+v", tc.features, response)
+		}
+		if got := pairSearches.Load(); got != tc.pairSearches {
+			t.Fatalf("features %q: expected %d airport pair searches, got %d", tc.features, tc.pairSearches, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"golang.org/x/text/language"
+)
+
+// locales holds the translated tool titles and descriptions, one file per language
+// keyed by tool name. English is the source language and lives in the code.
+//
+//go:embed locales/*.json
+var locales embed.FS
+
+type toolText struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// toolTexts maps tool names to their translated metadata.
+type toolTexts map[string]toolText
+
+// loadToolTexts returns the translations best matching lang, or nil for English.
+func loadToolTexts(lang string) (toolTexts, error) {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, fmt.Errorf("parse tool language: %w", err)
+	}
+
+	entries, err := locales.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+	supported := []language.Tag{language.English}
+	for _, e := range entries {
+		supported = append(supported, language.MustParse(strings.TrimSuffix(e.Name(), ".json")))
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(tag)
+	if confidence == language.No {
+		names := make([]string, 0, len(supported))
+		for _, t := range supported {
+			names = append(names, t.String())
+		}
+		return nil, fmt.Errorf("no tool translations for %q, available: %s", lang, strings.Join(names, ", "))
+	}
+	if index == 0 {
+		return nil, nil
+	}
+
+	data, err := locales.ReadFile(path.Join("locales", entries[index-1].Name()))
+	if err != nil {
+		return nil, err
+	}
+	var texts toolTexts
+	if err := json.Unmarshal(data, &texts); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", entries[index-1].Name(), err)
+	}
+	return texts, nil
+}
+
+// localize replaces the title and description of tool with their translations, if any.
+// The tool name is never translated, clients call tools by name.
+func (t toolTexts) localize(tool *mcp.Tool) *mcp.Tool {
+	if text, ok := t[tool.Name]; ok {
+		if text.Title != "" {
+			tool.Title = text.Title
+		}
+		if text.Description != "" {
+			tool.Description = text.Description
+		}
+	}
+	return tool
+}
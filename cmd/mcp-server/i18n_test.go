@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLoadToolTexts(t *testing.T) {
+	texts, err := loadToolTexts("en-GB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if texts != nil {
+		t.Fatal("English is served from the code and has no bundle")
+	}
+
+	texts, err = loadToolTexts("de-AT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if texts["server_info"].Title != "Serverinformationen" {
+		t.Fatalf("expected the German bundle, got: %+v", texts["server_info"])
+	}
+
+	if _, err := loadToolTexts("ja"); err == nil {
+		t.Fatal("expected an error for a language without translations")
+	}
+}
+
+// TestToolTranslationsComplete makes sure that every bundle translates every tool,
+// so that new tools don't show up half translated.
+func TestToolTranslationsComplete(t *testing.T) {
+	tools, err := connect(t, newTestServer(t, &fakeSession{})).ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := locales.ReadDir("locales")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		texts, err := loadToolTexts(strings.TrimSuffix(e.Name(), ".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		registered := map[string]bool{}
+		for _, tool := range tools.Tools {
+			registered[tool.Name] = true
+			if text := texts[tool.Name]; text.Title == "" || text.Description == "" {
+				t.Errorf("%s: missing translation of %q", e.Name(), tool.Name)
+			}
+		}
+		for name := range texts {
+			if !registered[name] {
+				t.Errorf("%s: translation of unknown tool %q", e.Name(), name)
+			}
+		}
+	}
+}
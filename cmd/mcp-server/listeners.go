@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// listener is a group of addresses serving the same handler with the same TLS
+// settings, e.g. the MCP endpoint on 127.0.0.1:8080 and [::1]:8080.
+type listener struct {
+	name     string // shown in logs and errors
+	addrs    []string
+	handler  http.Handler
+	certFile string // empty serves plain HTTP
+	keyFile  string
+}
+
+// newListener parses the comma-separated addrs of a listener and checks its TLS
+// settings: a certificate needs its key and vice versa.
+func newListener(name, addrs, certFile, keyFile string, handler http.Handler) (listener, error) {
+	l := listener{name: name, addrs: splitList(addrs), handler: handler, certFile: certFile, keyFile: keyFile}
+	if len(l.addrs) == 0 {
+		return listener{}, fmt.Errorf("%s listener has no address", name)
+	}
+	for _, addr := range l.addrs {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return listener{}, fmt.Errorf("%s listener address %q: %w", name, addr, err)
+		}
+	}
+	if (certFile == "") != (keyFile == "") {
+		return listener{}, fmt.Errorf("%s listener needs both a TLS certificate and a key", name)
+	}
+	return l, nil
+}
+
+func (l listener) scheme() string {
+	if l.certFile != "" {
+		return "https"
+	}
+	return "http"
+}
+
+// serveListeners serves every address of listeners until ctx is done or one of
+// them fails, which stops the others as well. Stopping gives running requests up
+// to timeout to finish before the remaining connections are closed, which
+// cancels the tool calls still running on them.
+func serveListeners(ctx context.Context, listeners []listener, timeout time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	for _, l := range listeners {
+		for _, addr := range l.addrs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := serveHTTP(ctx, addr, l, timeout); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = fmt.Errorf("%s listener %s: %w", l.name, addr, err)
+					}
+					mu.Unlock()
+					cancel()
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// serveHTTP serves l on addr until ctx is done, then shuts the server down.
+func serveHTTP(ctx context.Context, addr string, l listener, timeout time.Duration) error {
+	srv := &http.Server{Addr: addr, Handler: l.handler}
+	errc := make(chan error, 1)
+	go func() {
+		if l.certFile != "" {
+			errc <- srv.ListenAndServeTLS(l.certFile, l.keyFile)
+			return
+		}
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		// Event streams never become idle, so Shutdown gives up on them.
+		srv.Close()
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// requireBearerToken returns a handler answering requests without token as their
+// bearer token with 401 Unauthorized.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provided := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// freeAddr returns a local address nothing listens on.
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestNewListener(t *testing.T) {
+	handler := http.NotFoundHandler()
+	l, err := newListener("MCP", "127.0.0.1:8080, [::1]:8080", "", "", handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.addrs) != 2 || l.addrs[1] != "[::1]:8080" || l.scheme() != "http" {
+		t.Fatalf("unexpected listener %+v", l)
+	}
+	if l, err = newListener("admin", "0.0.0.0:9090", "cert.pem", "key.pem", handler); err != nil || l.scheme() != "https" {
+		t.Fatalf("expected an HTTPS listener, got %+v, %v", l, err)
+	}
+
+	for _, tc := range []struct{ addrs, cert, key string }{
+		{addrs: ""},
+		{addrs: "8080"},
+		{addrs: "127.0.0.1:8080,::1:8080"},
+		{addrs: "127.0.0.1:8080", cert: "cert.pem"},
+		{addrs: "127.0.0.1:8080", key: "key.pem"},
+	} {
+		if _, err := newListener("MCP", tc.addrs, tc.cert, tc.key, handler); err == nil {
+			t.Errorf("expected an error for %+v", tc)
+		}
+	}
+}
+
+// TestServeListeners checks that every address of every listener serves its own
+// handler until the context is done.
+func TestServeListeners(t *testing.T) {
+	mcpAddrs := []string{freeAddr(t), freeAddr(t)}
+	adminAddr := freeAddr(t)
+	respond := func(body string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		})
+	}
+	mcpListener, err := newListener("MCP", mcpAddrs[0]+","+mcpAddrs[1], "", "", requireBearerToken("secret", respond("mcp")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminListener, err := newListener("admin", adminAddr, "", "", respond("admin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- serveListeners(ctx, []listener{mcpListener, adminListener}, time.Second)
+	}()
+
+	get := func(addr, token string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, "http://"+addr+"/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for range 50 { // wait for the server to listen
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			defer resp.Body.Close()
+			var body [16]byte
+			n, _ := resp.Body.Read(body[:])
+			return resp.StatusCode, string(body[:n])
+		}
+		t.Fatalf("%s doesn't listen", addr)
+		return 0, ""
+	}
+	for _, addr := range mcpAddrs {
+		if code, body := get(addr, "secret"); code != http.StatusOK || body != "mcp" {
+			t.Errorf("%s: got %d %q", addr, code, body)
+		}
+		if code, _ := get(addr, ""); code != http.StatusUnauthorized {
+			t.Errorf("%s: expected 401 without a token, got %d", addr, code)
+		}
+	}
+	if code, body := get(adminAddr, ""); code != http.StatusOK || body != "admin" {
+		t.Errorf("%s: got %d %q", adminAddr, code, body)
+	}
+
+	stop()
+	if err := <-served; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestServeListenersFailure checks that a listener failing to listen stops the
+// other ones.
+func TestServeListenersFailure(t *testing.T) {
+	busy, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer busy.Close()
+
+	listeners := []listener{
+		{name: "MCP", addrs: []string{freeAddr(t)}, handler: http.NotFoundHandler()},
+		{name: "admin", addrs: []string{busy.Addr().String()}, handler: http.NotFoundHandler()},
+	}
+	served := make(chan error, 1)
+	go func() {
+		served <- serveListeners(context.Background(), listeners, time.Second)
+	}()
+	select {
+	case err := <-served:
+		if err == nil {
+			t.Fatal("expected an error for the address in use")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the other listener kept serving")
+	}
+}
+
+// TestServeHTTPShutdown checks that a running request may finish when the server
+// is stopped, and that connections still busy after the timeout are closed.
+func TestServeHTTPShutdown(t *testing.T) {
+	addr := freeAddr(t)
+
+	started := make(chan struct{}, 2)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		if r.URL.Path == "/hang" {
+			<-r.Context().Done()
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte("done"))
+	})
+
+	ctx, stop := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- serveHTTP(ctx, addr, listener{name: "test", handler: handler}, 500*time.Millisecond)
+	}()
+
+	get := func(path string) chan error {
+		errc := make(chan error, 1)
+		go func() {
+			var resp *http.Response
+			var err error
+			for range 50 { // wait for the server to listen
+				if resp, err = http.Get("http://" + addr + path); err == nil {
+					resp.Body.Close()
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			errc <- err
+		}()
+		return errc
+	}
+	finished, hanging := get("/finish"), get("/hang")
+	<-started
+	<-started
+	stop()
+
+	if err := <-finished; err != nil {
+		t.Fatalf("the running request didn't finish: %v", err)
+	}
+	if err := <-hanging; err == nil {
+		t.Fatal("expected the hanging request to be closed")
+	}
+	if err := <-served; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/telemetry"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// fakeSession is an offline replacement of *flights.Session. Unset functions
+// return empty results.
+type fakeSession struct {
+	getPriceGraph func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error)
+	getOffers     func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error)
+	serializeErr  error // returned by SerializeURL
+}
+
+func (f *fakeSession) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	if f.getPriceGraph == nil {
+		return nil, nil
+	}
+	return f.getPriceGraph(ctx, args)
+}
+
+func (f *fakeSession) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	if f.getOffers == nil {
+		return nil, nil, nil
+	}
+	return f.getOffers(ctx, args)
+}
+
+func (f *fakeSession) SerializeURL(ctx context.Context, args flights.Args) (string, error) {
+	if f.serializeErr != nil {
+		return "", f.serializeErr
+	}
+	return "https://www.google.com/travel/flights/search?tfs=test", nil
+}
+
+func newTestServer(t *testing.T, session *fakeSession) *server {
+	t.Helper()
+	s, err := newOfflineServer(session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// newOfflineServer creates a server searching session with the default settings.
+func newOfflineServer(session *fakeSession) (*server, error) {
+	features, err := parseFeatures("")
+	if err != nil {
+		return nil, err
+	}
+	meter := newUsageMeter(session, 0, defaultInteractiveReserve)
+	st := newMemoryStore("")
+	watches, err := loadWatchList(context.Background(), st)
+	if err != nil {
+		return nil, err
+	}
+	return &server{
+		session:  meter,
+		usage:    meter,
+		tracker:  newSearchTracker(),
+		features: features,
+		store:    st,
+		watches:  watches,
+
+		schemaVersion: currentSchemaVersion,
+	}, nil
+}
+
+// connect starts the MCP server over an in-memory transport and returns a connected client session.
+func connect(t *testing.T, s *server) *mcp.ClientSession {
+	t.Helper()
+	return connectClient(t, s, nil)
+}
+
+// connectClient is connect with client options.
+func connectClient(t *testing.T, s *server, opts *mcp.ClientOptions) *mcp.ClientSession {
+	t.Helper()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	ctx := context.Background()
+	serverSession, err := s.newMCPServer(ctx).Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, opts)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { clientSession.Close() })
+	return clientSession
+}
+
+func findCheapestOffersArgs() map[string]any {
+	start := time.Now().AddDate(0, 1, 0)
+	return map[string]any{
+		"rangeStartDate": start.Format(time.DateOnly),
+		"rangeEndDate":   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		"tripLengths":    []int{7},
+		"srcCities":      []string{"San Francisco"},
+		"dstCities":      []string{"New York"},
+	}
+}
+
+func TestCancelToolCallAbortsUpstreamCalls(t *testing.T) {
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+
+	session := &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			close(started)
+			<-ctx.Done()
+			close(aborted)
+			return nil, ctx.Err()
+		},
+	}
+	clientSession := connect(t, newTestServer(t, session))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := clientSession.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "find_cheapest_offers",
+			Arguments: findCheapestOffersArgs(),
+		})
+		errCh <- err
+	}()
+
+	<-started
+	cancel()
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the cancellation notification didn't cancel the upstream call")
+	}
+	if err := <-errCh; err == nil {
+		t.Fatal("expected the canceled call to fail")
+	}
+}
+
+func TestClientDisconnectAbortsUpstreamCalls(t *testing.T) {
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+
+	session := &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			close(started)
+			<-ctx.Done()
+			close(aborted)
+			return nil, ctx.Err()
+		},
+	}
+	s := newTestServer(t, session)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	connCtx, disconnect := context.WithCancel(context.Background())
+	defer disconnect()
+
+	// The SSE handler binds sessions to the context of the hanging GET request,
+	// which is canceled when the client goes away.
+	if _, err := s.newMCPServer(connCtx).Connect(connCtx, serverTransport, nil); err != nil {
+		t.Fatal(err)
+	}
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+	clientSession, err := client.Connect(context.Background(), clientTransport, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientSession.Close()
+
+	go clientSession.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "find_cheapest_offers",
+		Arguments: findCheapestOffersArgs(),
+	})
+
+	<-started
+	disconnect()
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("disconnecting the client didn't cancel the upstream call")
+	}
+}
+
+func TestTelemetryCountsToolCalls(t *testing.T) {
+	var report telemetry.Report
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer collector.Close()
+
+	s := newTestServer(t, &fakeSession{})
+	s.telemetry = telemetry.NewReporter(collector.URL, serverVersion)
+	clientSession := connect(t, s)
+
+	ctx := context.Background()
+	if _, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: "server_info", Arguments: map[string]any{}}); err != nil {
+		t.Fatal(err)
+	}
+	// An invalid date fails the call.
+	args := findCheapestOffersArgs()
+	args["rangeStartDate"] = "tomorrow"
+	if _, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: "find_cheapest_offers", Arguments: args}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.telemetry.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	expected := []telemetry.ToolStats{
+		{Name: "find_cheapest_offers", Calls: 1, Errors: 1},
+		{Name: "server_info", Calls: 1},
+	}
+	if !reflect.DeepEqual(report.Tools, expected) {
+		t.Fatalf("wrong tool stats: %+v", report.Tools)
+	}
+}
+
+func TestFindCheapestOffersDebug(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	start := time.Now().AddDate(0, 1, 0)
+	params := findCheapestOffersParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		TripLengths:    []int{5, 7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+	}
+	_, response, err := s.findCheapestOffers(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Requests != nil {
+		t.Fatalf("expected no request stats outside debug mode, got %+v", response.Requests)
+	}
+
+	s.debug = true
+	_, response, err = s.findCheapestOffers(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Requests == nil || response.Requests.PriceGraph != 2 || response.Requests.Upstream != 2 {
+		t.Fatalf("expected a price graph request per trip length, got %+v", response.Requests)
+	}
+}
+
+func TestFindCheapestOffersDeadline(t *testing.T) {
+	start := time.Now().AddDate(0, 1, 0)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: 100}}, nil
+		},
+	})
+	params := findCheapestOffersParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		TripLengths:    []int{7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+		// All of it is the margin left for the response.
+		DeadlineSeconds: 1,
+	}
+	result, response, err := s.findCheapestOffers(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.DeadlineReached || response.VerifiedDates != 0 || response.NoResults == nil {
+		t.Fatalf("expected no date to fit the deadline, got %+v", response)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "deadlineSeconds") {
+		t.Fatalf("expected the summary to mention the deadline, got %q", text)
+	}
+
+	params.DeadlineSeconds = -1
+	if _, _, err := s.findCheapestOffers(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error for a negative deadlineSeconds")
+	}
+}
+
+func TestDurationHints(t *testing.T) {
+	tools, err := connect(t, newTestServer(t, &fakeSession{})).ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hinted := 0
+	for _, tool := range tools.Tools {
+		hint, ok := tool.Meta["durationHint"].(map[string]any)
+		if !ok {
+			continue
+		}
+		hinted++
+		if hint["maxSeconds"] == nil || hint["typicalSeconds"] == nil {
+			t.Errorf("%s: incomplete duration hint %v", tool.Name, hint)
+		}
+		if tool.Name == "find_cheapest_offers" && hint["deadlineParam"] != "deadlineSeconds" {
+			t.Errorf("expected find_cheapest_offers to name its deadline parameter, got %v", hint)
+		}
+	}
+	if hinted < len(toolDurations) {
+		t.Fatalf("expected all %d tools with a duration hint to publish it, got %d", len(toolDurations), hinted)
+	}
+}
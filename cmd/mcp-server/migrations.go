@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationFiles holds the versioned schema of sqlStore, NNNN_name.sql files that are
+// applied in order of their version. Times are stored as Unix nanoseconds and nested
+// values as JSON, which SQLite and Postgres handle alike. Applied migrations must not
+// change, a schema change is a new migration.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migration is a step of the schema of sqlStore.
+type migration struct {
+	version    int
+	file       string
+	statements string
+}
+
+// loadMigrations returns the embedded migrations, oldest first. Their versions
+// count up from 1 without gaps.
+func loadMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	var migrations []migration
+	for _, e := range entries {
+		version, _, ok := strings.Cut(strings.TrimSuffix(e.Name(), ".sql"), "_")
+		v, err := strconv.Atoi(version)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("migration %s isn't named NNNN_name.sql", e.Name())
+		}
+		if v != len(migrations)+1 {
+			return nil, fmt.Errorf("migration %s has version %d, expected %d", e.Name(), v, len(migrations)+1)
+		}
+		statements, err := fs.ReadFile(migrationFiles, "migrations/"+e.Name())
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, migration{version: v, file: e.Name(), statements: string(statements)})
+	}
+	return migrations, nil
+}
+
+// migrate applies the migrations the database lacks, each in a transaction of its
+// own, and returns the schema version of the database. A database of a newer
+// server isn't touched.
+func (s *sqlStore) migrate(ctx context.Context) (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	_, err = s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at BIGINT NOT NULL
+	)`)
+	if err != nil {
+		return 0, err
+	}
+	version, err := s.schemaVersion(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if version > len(migrations) {
+		return version, fmt.Errorf("the database has schema version %d, newer than version %d of this server; upgrade the server", version, len(migrations))
+	}
+	for _, m := range migrations[version:] {
+		err := s.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, m.statements); err != nil {
+				return err
+			}
+			// A concurrent migration of the same version fails here and is rolled back.
+			return s.exec(ctx, tx, `INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)`, m.version, time.Now().UnixNano())
+		})
+		if err != nil {
+			return version, fmt.Errorf("apply migration %s: %v", m.file, err)
+		}
+		version = m.version
+	}
+	return version, nil
+}
+
+// schemaVersion returns the version of the last migration applied to the database.
+func (s *sqlStore) schemaVersion(ctx context.Context) (int, error) {
+	var version int
+	err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	return version, err
+}
@@ -0,0 +1,28 @@
+-- The tables of the first sql store. They're only created if they don't exist, as
+-- the databases of the servers predating the migrations have them already.
+CREATE TABLE IF NOT EXISTS offers (
+	id TEXT PRIMARY KEY,
+	stored_at BIGINT NOT NULL,
+	offer TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS offers_stored_at ON offers (stored_at);
+
+CREATE TABLE IF NOT EXISTS searches (
+	id TEXT PRIMARY KEY,
+	created_at BIGINT NOT NULL,
+	deleted_at BIGINT NOT NULL DEFAULT 0,
+	offers TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS searches_created_at ON searches (created_at);
+
+CREATE TABLE IF NOT EXISTS fares (
+	fare_key TEXT PRIMARY KEY,
+	observed_at BIGINT NOT NULL,
+	points TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS fares_observed_at ON fares (observed_at);
+
+CREATE TABLE IF NOT EXISTS watch_list (
+	id INTEGER PRIMARY KEY,
+	watches TEXT NOT NULL
+);
@@ -0,0 +1,6 @@
+-- The claim of the server instance using the database, see sqlStore.Lock.
+CREATE TABLE IF NOT EXISTS instance_lock (
+	id INTEGER PRIMARY KEY,
+	owner TEXT NOT NULL,
+	renewed_at BIGINT NOT NULL
+);
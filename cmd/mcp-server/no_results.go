@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+)
+
+// noResultsResponse explains why find_cheapest_offers found no offers and how the
+// search could be relaxed.
+type noResultsResponse struct {
+	ScannedDates int `json:"scannedDates"` // dates whose offers were searched
+
+	// The cheapest offer found and how far it was above Google's low price, which an
+	// offer must be below to qualify. Omitted if no date had a priced offer.
+	LowestPrice     float64 `json:"lowestPrice,omitempty"`
+	LowestPriceDate string  `json:"lowestPriceDate,omitempty"`
+	ThresholdPrice  float64 `json:"thresholdPrice,omitempty"`
+	ThresholdGap    float64 `json:"thresholdGap,omitempty"`
+
+	PolicyRejected int `json:"policyRejected,omitempty"` // qualifying offers dropped by policyCompliantOnly
+
+	// NearMisses are the cheapest offers that weren't below Google's low price,
+	// cheapest first, so that the search still suggests dates to consider.
+	NearMisses []offerResponse `json:"nearMisses,omitempty"`
+
+	Suggestions []string `json:"suggestions"`
+}
+
+// explainNoResults builds the explanation of a search that found no offers.
+// policyRejected is the number of offers dropped because of policyCompliantOnly.
+func (s *server) explainNoResults(params findCheapestOffersParams, stats cheapoffers.Stats, policyRejected int) *noResultsResponse {
+	explanation := &noResultsResponse{
+		ScannedDates:   stats.VerifiedDates,
+		PolicyRejected: policyRejected,
+		Suggestions:    []string{},
+	}
+	suggest := func(format string, a ...any) {
+		explanation.Suggestions = append(explanation.Suggestions, fmt.Sprintf(format, a...))
+	}
+
+	if stats.PriceGraphDates == 0 {
+		suggest("Google reported no prices between %s and %s; check the city names, widen the date range or try other trip lengths.",
+			params.RangeStartDate, params.RangeEndDate)
+	}
+	if stats.Filtered > 0 {
+		suggest("%d offer(s) of the searched dates didn't match the filters; relax maxPrice, maxTotalDurationHours, the airlines or the departure times.", stats.Filtered)
+	}
+	if policyRejected > 0 {
+		suggest("%d offer(s) didn't comply with the travel policy; drop policyCompliantOnly to see them.", policyRejected)
+	}
+	if len(stats.NearMisses) > 0 {
+		miss := stats.NearMisses[0]
+		explanation.LowestPrice = s.roundPrice(miss.Price)
+		explanation.LowestPriceDate = miss.StartDate.Format(time.DateOnly)
+		explanation.ThresholdPrice = s.roundPrice(miss.LowPrice)
+		explanation.ThresholdGap = explanation.LowestPrice - explanation.ThresholdPrice
+		suggest("The cheapest offer, %s on %s, is %s above Google's low price of %s; the route is at its usual price, widen the date range or add trip lengths to find a dip.",
+			s.formatPrice(explanation.LowestPrice), explanation.LowestPriceDate, s.formatPrice(explanation.ThresholdGap), s.formatPrice(explanation.ThresholdPrice))
+	} else if stats.VerifiedDates > len(stats.Skipped) && policyRejected == 0 && stats.Filtered == 0 {
+		suggest("None of the %d searched date(s) had a priced offer; try other dates or airports.", stats.VerifiedDates-len(stats.Skipped))
+	}
+	if len(stats.Skipped) > 0 {
+		suggest("The search of %d date(s) failed; search again to cover them.", len(stats.Skipped))
+	}
+	if stats.Truncated {
+		suggest("Only the %d cheapest of %d dates were searched; raise maxRequests to search more.",
+			stats.VerifiedDates, stats.PriceGraphDates)
+	}
+	if stats.DeadlineReached {
+		suggest("Only the %d cheapest of %d dates could be searched within deadlineSeconds; allow more time to search more.",
+			stats.VerifiedDates, stats.PriceGraphDates)
+	}
+	if params.MaxStops != nil {
+		suggest("Allow more than %d stop(s); maxStops narrows the offers Google returns.", *params.MaxStops)
+	}
+	if params.Class != "" && params.Class != "economy" {
+		suggest("Search economy instead of %s.", params.Class)
+	}
+	return explanation
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestFindCheapestOffersNoResults(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 30)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			var graph []flights.Offer
+			for i := range 3 {
+				date := start.AddDate(0, 0, i)
+				graph = append(graph, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: float64(400 + i)})
+			}
+			return graph, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			// Every date is 20 to 22 above Google's low price.
+			price := 420 + args.Date.Sub(start).Hours()/24
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: price},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 400, High: 600}, nil
+		},
+	})
+
+	maxStops := 0
+	_, response, err := s.findCheapestOffers(context.Background(), nil, findCheapestOffersParams{
+		RangeStartDate:    start.Format(time.DateOnly),
+		RangeEndDate:      start.AddDate(0, 0, 2).Format(time.DateOnly),
+		TripLengths:       []int{7},
+		SrcCities:         []string{"San Francisco"},
+		DstCities:         []string{"New York"},
+		tripOptionsParams: tripOptionsParams{MaxStops: &maxStops},
+		MaxRequests:       1 + 2*3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Offers) != 0 {
+		t.Fatalf("expected no offers, got %d", len(response.Offers))
+	}
+	explanation := response.NoResults
+	if explanation == nil {
+		t.Fatal("expected an explanation")
+	}
+	if explanation.ScannedDates != 2 || explanation.LowestPrice != 420 || explanation.ThresholdPrice != 400 || explanation.ThresholdGap != 20 {
+		t.Fatalf("wrong explanation: %+v", explanation)
+	}
+	if explanation.LowestPriceDate != start.Format(time.DateOnly) {
+		t.Fatalf("wrong lowest price date: %s", explanation.LowestPriceDate)
+	}
+	if len(explanation.NearMisses) != 2 || explanation.NearMisses[0].Price != 420 || explanation.NearMisses[1].Price != 421 {
+		t.Fatalf("expected the searched dates as near misses: %+v", explanation.NearMisses)
+	}
+	if explanation.NearMisses[0].ShareableLink == "" {
+		t.Fatal("expected near misses to have a link")
+	}
+	if _, ok, err := s.store.Offer(context.Background(), explanation.NearMisses[0].ID); err != nil || !ok {
+		t.Fatalf("near miss not saved: %v", err)
+	}
+	suggestions := strings.Join(explanation.Suggestions, "\n")
+	for _, want := range []string{"widen the date range", "maxRequests", "maxStops"} {
+		if !strings.Contains(suggestions, want) {
+			t.Errorf("expected a suggestion mentioning %s: %s", want, suggestions)
+		}
+	}
+}
+
+func TestFindCheapestOffersNoPriceGraph(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	start := time.Now().AddDate(0, 1, 0)
+	_, response, err := s.findCheapestOffers(context.Background(), nil, findCheapestOffersParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		TripLengths:    []int{7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"Atlantis"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.NoResults == nil || response.NoResults.LowestPrice != 0 || len(response.NoResults.Suggestions) != 1 {
+		t.Fatalf("expected only the empty price graph to be explained: %+v", response.NoResults)
+	}
+}
+
+func TestFindCheapestOffersPriceContext(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 30)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			var graph []flights.Offer
+			for i := range 3 {
+				date := start.AddDate(0, 0, i)
+				graph = append(graph, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: 300})
+			}
+			return graph, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			// 350, 500 and 650 on consecutive dates.
+			price := 350 + 150*args.Date.Sub(start).Hours()/24
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: price},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 400, High: 600}, nil
+		},
+	})
+	params := findCheapestOffersParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 2).Format(time.DateOnly),
+		TripLengths:    []int{7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+	}
+
+	_, response, err := s.findCheapestOffers(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.PriceContext != nil {
+		t.Fatalf("expected no price context unless asked for, got %+v", response.PriceContext)
+	}
+
+	params.IncludePriceContext = true
+	_, response, err = s.findCheapestOffers(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var levels []string
+	for _, p := range response.PriceContext {
+		if p.LowPrice != 400 || p.HighPrice != 600 || p.Qualified != (p.Level == priceLevelLow) {
+			t.Fatalf("wrong price context: %+v", p)
+		}
+		levels = append(levels, p.Level)
+	}
+	if strings.Join(levels, ",") != "low,typical,high" {
+		t.Fatalf("expected a low, typical and high date, got %v", levels)
+	}
+}
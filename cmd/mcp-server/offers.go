@@ -0,0 +1,215 @@
+package main
+
+import (
+	"container/list"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// Sources of the prices of an offer, so that clients can tell fresh prices from
+// remembered ones.
+const (
+	sourceLive    = "live"    // fetched from Google Flights during the tool call
+	sourceCache   = "cache"   // reused from a recent search, as of its fetchedAt
+	sourceHistory = "history" // remembered from an earlier search, as of its fetchedAt
+)
+
+// maxRegisteredOffers bounds the number of offers remembered for follow-up tool calls.
+const maxRegisteredOffers = 5000
+
+// registeredOffer is an offer returned to a client, together with the search
+// arguments that reproduce it.
+type registeredOffer struct {
+	Args  flights.Args // single airport pair and exact dates of the offer
+	Price float64
+}
+
+// offerRegistry remembers recently returned offers by their ID so that follow-up
+// tools can refer to an offer by ID only. The oldest offers are evicted first.
+type offerRegistry struct {
+	mu     sync.Mutex
+	offers map[string]registeredOffer
+	order  []string
+}
+
+func newOfferRegistry() *offerRegistry {
+	return &offerRegistry{offers: map[string]registeredOffer{}}
+}
+
+func (r *offerRegistry) Store(id string, offer registeredOffer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.offers[id]; !ok {
+		r.order = append(r.order, id)
+	}
+	r.offers[id] = offer
+
+	for len(r.order) > maxRegisteredOffers {
+		delete(r.offers, r.order[0])
+		r.order = r.order[1:]
+	}
+}
+
+func (r *offerRegistry) Load(id string) (registeredOffer, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	offer, ok := r.offers[id]
+	return offer, ok
+}
+
+// maxRegisteredSearches bounds the number of search results remembered for diffing.
+const maxRegisteredSearches = 100
+
+// registeredSearch is the response of a search remembered under its search ID.
+type registeredSearch struct {
+	CreatedAt time.Time
+	Offers    []offerResponse
+	DeletedAt time.Time // zero unless the search is deleted
+}
+
+// searchRegistry remembers the most recent search responses by search ID.
+type searchRegistry struct {
+	mu       sync.Mutex
+	searches map[string]registeredSearch
+	order    []string
+}
+
+func newSearchRegistry() *searchRegistry {
+	return &searchRegistry{searches: map[string]registeredSearch{}}
+}
+
+// Store remembers the offers and returns the new search ID.
+func (r *searchRegistry) Store(offers []offerResponse) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := newSearchID()
+	r.searches[id] = registeredSearch{CreatedAt: time.Now(), Offers: offers}
+	r.order = append(r.order, id)
+
+	for len(r.order) > maxRegisteredSearches {
+		delete(r.searches, r.order[0])
+		r.order = r.order[1:]
+	}
+	return id
+}
+
+func (r *searchRegistry) Load(id string) (registeredSearch, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	search, ok := r.searches[id]
+	if !ok || !search.DeletedAt.IsZero() {
+		return registeredSearch{}, false
+	}
+	return search, true
+}
+
+// Delete marks a search deleted, it's kept for Restore until it's evicted. It
+// returns false if the search is unknown or already deleted.
+func (r *searchRegistry) Delete(id string, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	search, ok := r.searches[id]
+	if !ok || !search.DeletedAt.IsZero() {
+		return false
+	}
+	search.DeletedAt = now
+	r.searches[id] = search
+	return true
+}
+
+// Restore undoes the deletion of a search deleted less than deletedRetention ago.
+func (r *searchRegistry) Restore(id string, now time.Time) (registeredSearch, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	search, ok := r.searches[id]
+	if !ok || search.DeletedAt.IsZero() || now.Sub(search.DeletedAt) >= deletedRetention {
+		return registeredSearch{}, false
+	}
+	search.DeletedAt = time.Time{}
+	r.searches[id] = search
+	return search, true
+}
+
+// maxObservedFares bounds the number of fares whose prices are remembered, and
+// maxFareObservations the number of prices remembered per fare.
+const (
+	maxObservedFares    = 5000
+	maxFareObservations = 50
+)
+
+// fareRegistry remembers the prices returned for fares across searches, so that
+// offers can tell how long their prices tend to last. The fares not observed for
+// the longest time are evicted first. It's the fare history of the memory store,
+// which is lost on restart, the sqlite and postgres stores keep theirs in the
+// database.
+type fareRegistry struct {
+	mu    sync.Mutex
+	fares map[string]*list.Element // of order
+	order *list.List               // of *observedFare, the latest observed first
+}
+
+type observedFare struct {
+	key    string
+	points []pricePoint
+}
+
+func newFareRegistry() *fareRegistry {
+	return &fareRegistry{fares: map[string]*list.Element{}, order: list.New()}
+}
+
+// Observe records a price of the fare and returns a copy of its prices, oldest
+// first. A price observed at the same time as the latest one, e.g. a cached
+// result returned again, isn't recorded twice.
+func (r *fareRegistry) Observe(key string, point pricePoint) []pricePoint {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.fares[key]
+	if ok {
+		r.order.MoveToFront(e)
+	} else {
+		e = r.order.PushFront(&observedFare{key: key})
+		r.fares[key] = e
+	}
+	fare := e.Value.(*observedFare)
+	fare.points = appendFarePoint(fare.points, point)
+
+	for r.order.Len() > maxObservedFares {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.fares, oldest.Value.(*observedFare).key)
+	}
+	return slices.Clone(fare.points)
+}
+
+// appendFarePoint adds point to the prices of a fare, oldest first, and keeps the
+// latest maxFareObservations.
+func appendFarePoint(points []pricePoint, point pricePoint) []pricePoint {
+	if n := len(points); n > 0 && points[n-1].At.Equal(point.At) {
+		return points
+	}
+	points = append(points, point)
+	sort.SliceStable(points, func(i, j int) bool { return points[i].At.Before(points[j].At) })
+	return points[max(len(points)-maxFareObservations, 0):]
+}
+
+func newSearchID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// checkOutput validates the value of the --output flag.
+func checkOutput(output string) error {
+	switch output {
+	case "json", "table", "csv":
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q, expected json, table or csv", output)
+}
+
+// writeOutput writes the result of a subcommand. The json format writes value,
+// the structured response also returned by the corresponding tool. The table and
+// csv formats write the rows under the given header.
+func writeOutput(w io.Writer, output string, value any, header []string, rows [][]string) error {
+	switch output {
+	case "table":
+		return writeTable(w, header, rows)
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(header)
+		cw.WriteAll(rows)
+		return cw.Error()
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// writeTable writes rows as columns aligned with spaces under the upper-cased header.
+func writeTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
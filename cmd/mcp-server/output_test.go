@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteOutput(t *testing.T) {
+	value := diagnosticCheck{Name: "store", Status: checkOK, Detail: "reachable, in memory"}
+	header := []string{"name", "status", "detail"}
+	rows := [][]string{{value.Name, value.Status, value.Detail}}
+
+	for output, expected := range map[string]string{
+		"json":  "{\n  \"name\": \"store\",\n  \"status\": \"ok\",\n  \"detail\": \"reachable, in memory\"\n}\n",
+		"table": "NAME   STATUS  DETAIL\nstore  ok      reachable, in memory\n",
+		"csv":   "name,status,detail\nstore,ok,\"reachable, in memory\"\n",
+	} {
+		if err := checkOutput(output); err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := writeOutput(&buf, output, value, header, rows); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != expected {
+			t.Errorf("%s: expected %q, got %q", output, expected, buf.String())
+		}
+	}
+
+	if err := checkOutput("yaml"); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
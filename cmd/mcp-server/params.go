@@ -0,0 +1,311 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/iata"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+func parseDate(name, value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse %s: %w", name, err)
+	}
+	return date, nil
+}
+
+func parseLanguage(value string) (language.Tag, error) {
+	if value == "" {
+		return language.English, nil
+	}
+	lang, err := language.Parse(value)
+	if err != nil {
+		return language.Tag{}, fmt.Errorf("parse language: %w", err)
+	}
+	return lang, nil
+}
+
+func parseCurrency(value string) (currency.Unit, error) {
+	if value == "" {
+		return currency.USD, nil
+	}
+	curr, err := currency.ParseISO(value)
+	if err != nil {
+		return currency.Unit{}, fmt.Errorf("parse currency: %w", err)
+	}
+	return curr, nil
+}
+
+func parseAdults(value int) (int, error) {
+	if value == 0 {
+		return 1, nil
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("adults must be greater than zero")
+	}
+	return value, nil
+}
+
+// maxTravelers is the largest number of travelers Google Flights searches for.
+const maxTravelers = 9
+
+// searchOptionsParams are the trip options of the search tools that search one-way
+// trips as well as round trips.
+type searchOptionsParams struct {
+	TripType string `json:"tripType,omitempty" jsonschema:"Optional round-trip or one-way, defaults to round-trip"`
+	tripOptionsParams
+}
+
+// tripOptionsParams are the trip options shared by all search tools. The tools that
+// only search round trips embed them without a tripType, so that their schema doesn't
+// offer one-way trips.
+type tripOptionsParams struct {
+	Class       string `json:"class,omitempty" jsonschema:"Optional cabin class: economy, premium-economy, business or first; defaults to economy"`
+	MaxStops    *int   `json:"maxStops,omitempty" jsonschema:"Optional maximum number of stops: 0 for nonstop flights only, 1 or 2; defaults to any number"`
+	Adults      int    `json:"adults,omitempty" jsonschema:"Optional number of adult travelers, defaults to 1"`
+	Children    int    `json:"children,omitempty" jsonschema:"Optional number of children (2-11 years)"`
+	InfantsLap  int    `json:"infantsLap,omitempty" jsonschema:"Optional number of infants on a lap, at most one per adult"`
+	InfantsSeat int    `json:"infantsSeat,omitempty" jsonschema:"Optional number of infants in a seat"`
+}
+
+// filterParams are constraints on the offers a search reports.
+type filterParams struct {
+	MaxPrice              float64  `json:"maxPrice,omitempty" jsonschema:"Optional, only report offers at or below this price"`
+	MaxTotalDurationHours float64  `json:"maxTotalDurationHours,omitempty" jsonschema:"Optional, only report offers whose outbound trip including layovers takes at most this many hours"`
+	IncludeAirlines       []string `json:"includeAirlines,omitempty" jsonschema:"Optional airline names or IATA codes, only report offers whose outbound flights are all operated by them"`
+	ExcludeAirlines       []string `json:"excludeAirlines,omitempty" jsonschema:"Optional airline names or IATA codes, never report offers with an outbound flight operated by them"`
+	EarliestDeparture     string   `json:"earliestDeparture,omitempty" jsonschema:"Optional earliest local departure time of the outbound trip (HH:MM)"`
+	LatestDeparture       string   `json:"latestDeparture,omitempty" jsonschema:"Optional latest local departure time of the outbound trip (HH:MM)"`
+	AvoidCountries        []string `json:"avoidCountries,omitempty" jsonschema:"Optional countries, as ISO 3166 codes or English names, the outbound trip must not have a layover in, e.g. because of transit visa requirements"`
+
+	ExcludeOvernightLayovers bool `json:"excludeOvernightLayovers,omitempty" jsonschema:"Optional, never report offers whose outbound trip has a layover through the local night (at least an hour between midnight and 5am)"`
+}
+
+// parseFilters validates the filters and returns them as cheapoffers.Filters.
+func parseFilters(params filterParams) (cheapoffers.Filters, error) {
+	if params.MaxTotalDurationHours < 0 {
+		return cheapoffers.Filters{}, fmt.Errorf("maxTotalDurationHours must not be negative")
+	}
+	filters := cheapoffers.Filters{
+		MaxPrice:                 params.MaxPrice,
+		MaxTotalDuration:         time.Duration(params.MaxTotalDurationHours * float64(time.Hour)),
+		IncludeAirlines:          params.IncludeAirlines,
+		ExcludeAirlines:          params.ExcludeAirlines,
+		ExcludeOvernightLayovers: params.ExcludeOvernightLayovers,
+	}
+	var err error
+	if filters.EarliestDeparture, err = parseTimeOfDay("earliestDeparture", params.EarliestDeparture); err != nil {
+		return cheapoffers.Filters{}, err
+	}
+	if filters.LatestDeparture, err = parseTimeOfDay("latestDeparture", params.LatestDeparture); err != nil {
+		return cheapoffers.Filters{}, err
+	}
+	for _, country := range params.AvoidCountries {
+		code, ok := iata.ParseCountry(country)
+		if !ok {
+			return cheapoffers.Filters{}, fmt.Errorf("avoidCountries: unknown country %q, expected an ISO 3166 code such as RU", country)
+		}
+		filters.AvoidCountries = append(filters.AvoidCountries, code)
+	}
+	if err := filters.Validate(); err != nil {
+		return cheapoffers.Filters{}, err
+	}
+	return filters, nil
+}
+
+// originPenaltyParam weights a departure airport of a search.
+type originPenaltyParam struct {
+	Airport string  `json:"airport" jsonschema:"IATA code of a departure airport"`
+	Penalty float64 `json:"penalty" jsonschema:"Amount in the search currency added to the price of the offers from this airport when picking and ranking offers"`
+}
+
+// parseOriginPenalties validates the origin penalties and returns them by airport
+// code, nil if there are none.
+func parseOriginPenalties(params []originPenaltyParam) (map[string]float64, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	penalties := make(map[string]float64, len(params))
+	for _, p := range params {
+		airport := []string{p.Airport}
+		if err := checkAirportCodes(airport); err != nil {
+			return nil, fmt.Errorf("originPenalties: %w", err)
+		}
+		p.Airport = airport[0]
+		if p.Penalty < 0 {
+			return nil, fmt.Errorf("originPenalties: the penalty of %s must not be negative", p.Airport)
+		}
+		if _, ok := penalties[p.Airport]; ok {
+			return nil, fmt.Errorf("originPenalties: %s is listed twice", p.Airport)
+		}
+		penalties[p.Airport] = p.Penalty
+	}
+	return penalties, nil
+}
+
+// parseSelfTransferRisk returns whether the offers with self-transfer risk are
+// deranked or excluded, see cheapoffers.SelfTransferRisk.
+func parseSelfTransferRisk(value string) (derank, exclude bool, err error) {
+	switch value {
+	case "", "flag":
+		return false, false, nil
+	case "derank":
+		return true, false, nil
+	case "exclude":
+		return false, true, nil
+	default:
+		return false, false, fmt.Errorf("invalid selfTransferRisk %q, expected flag, derank or exclude", value)
+	}
+}
+
+// preferencesParams weight the properties of the offers of a search against their
+// price.
+type preferencesParams struct {
+	Price          float64 `json:"price,omitempty" jsonschema:"Weight of the price, defaults to 1. The other weights are amounts of the search currency at price weight 1, e.g. stops 200 values every avoided stop like 200 of savings"`
+	Stops          float64 `json:"stops,omitempty" jsonschema:"Weight of every outbound stop"`
+	DurationHours  float64 `json:"durationHours,omitempty" jsonschema:"Weight of every hour of the outbound trip including layovers"`
+	Departure      string  `json:"departure,omitempty" jsonschema:"Preferred local departure time of the outbound trip (HH:MM), used with departureHours"`
+	DepartureHours float64 `json:"departureHours,omitempty" jsonschema:"Weight of every hour the outbound departure is away from departure"`
+}
+
+// parsePreferences validates the preferences and returns them as
+// cheapoffers.Preferences, with the weights divided by the price weight.
+func parsePreferences(params *preferencesParams) (cheapoffers.Preferences, error) {
+	if params == nil {
+		return cheapoffers.Preferences{}, nil
+	}
+	price := params.Price
+	if price == 0 {
+		price = 1
+	}
+	if price < 0 {
+		return cheapoffers.Preferences{}, fmt.Errorf("preferences: the price weight must be positive")
+	}
+	if params.DepartureHours > 0 && params.Departure == "" {
+		return cheapoffers.Preferences{}, fmt.Errorf("preferences: departureHours requires departure")
+	}
+	departure, err := parseTimeOfDay("preferences.departure", params.Departure)
+	if err != nil {
+		return cheapoffers.Preferences{}, err
+	}
+	preferences := cheapoffers.Preferences{
+		PerStop:            params.Stops / price,
+		PerHour:            params.DurationHours / price,
+		PreferredDeparture: departure,
+		PerDepartureHour:   params.DepartureHours / price,
+	}
+	if err := preferences.Validate(); err != nil {
+		return cheapoffers.Preferences{}, fmt.Errorf("preferences: %w", err)
+	}
+	return preferences, nil
+}
+
+// parseTimeOfDay parses an HH:MM time of day into the offset from midnight, 0 if
+// value is empty.
+func parseTimeOfDay(name, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: expected HH:MM, got %q", name, value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// parseSearchOptions validates the trip options and returns them as flights.Options.
+func parseSearchOptions(params searchOptionsParams, lang language.Tag, curr currency.Unit) (flights.Options, error) {
+	options := flights.Options{
+		Currency: curr,
+		Lang:     lang,
+	}
+
+	switch params.TripType {
+	case "", "round-trip":
+		options.TripType = flights.RoundTrip
+	case "one-way":
+		options.TripType = flights.OneWay
+	default:
+		return flights.Options{}, fmt.Errorf("invalid tripType %q, expected round-trip or one-way", params.TripType)
+	}
+
+	switch params.Class {
+	case "", "economy":
+		options.Class = flights.Economy
+	case "premium-economy", "premium":
+		options.Class = flights.PremiumEconomy
+	case "business":
+		options.Class = flights.Business
+	case "first":
+		options.Class = flights.First
+	default:
+		return flights.Options{}, fmt.Errorf("invalid class %q, expected economy, premium-economy, business or first", params.Class)
+	}
+
+	options.Stops = flights.AnyStops
+	if params.MaxStops != nil {
+		switch *params.MaxStops {
+		case 0:
+			options.Stops = flights.Nonstop
+		case 1:
+			options.Stops = flights.Stop1
+		case 2:
+			options.Stops = flights.Stop2
+		default:
+			return flights.Options{}, fmt.Errorf("maxStops must be 0, 1 or 2, omit it for any number of stops")
+		}
+	}
+
+	adults, err := parseAdults(params.Adults)
+	if err != nil {
+		return flights.Options{}, err
+	}
+	if params.Children < 0 || params.InfantsLap < 0 || params.InfantsSeat < 0 {
+		return flights.Options{}, fmt.Errorf("children, infantsLap and infantsSeat must not be negative")
+	}
+	if params.InfantsLap > adults {
+		return flights.Options{}, fmt.Errorf("every infant on a lap needs an adult, got %d infants and %d adults", params.InfantsLap, adults)
+	}
+	if total := adults + params.Children + params.InfantsLap + params.InfantsSeat; total > maxTravelers {
+		return flights.Options{}, fmt.Errorf("at most %d travelers are supported, got %d", maxTravelers, total)
+	}
+	options.Travelers = flights.Travelers{
+		Adults:       adults,
+		Children:     params.Children,
+		InfantOnLap:  params.InfantsLap,
+		InfantInSeat: params.InfantsSeat,
+	}
+	return options, nil
+}
+
+// parseCityLanguages parses the comma-separated languages city names are also
+// looked up in.
+func parseCityLanguages(value string) ([]language.Tag, error) {
+	var langs []language.Tag
+	for _, l := range splitList(value) {
+		lang, err := language.Parse(l)
+		if err != nil {
+			return nil, fmt.Errorf("parse city language %q: %w", l, err)
+		}
+		langs = append(langs, lang)
+	}
+	return langs, nil
+}
+
+// parseMarket canonicalizes an ISO 3166-1 alpha-2 country code. An empty value leaves
+// the market up to Google.
+func parseMarket(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	region, err := language.ParseRegion(value)
+	if err != nil || !region.IsCountry() {
+		return "", fmt.Errorf("invalid market %q, expected an ISO 3166-1 alpha-2 country code", value)
+	}
+	return region.String(), nil
+}
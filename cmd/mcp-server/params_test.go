@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+func TestParseSearchOptions(t *testing.T) {
+	oneStop := 1
+	options, err := parseSearchOptions(searchOptionsParams{
+		TripType: "one-way",
+		tripOptionsParams: tripOptionsParams{
+			Class:       "business",
+			MaxStops:    &oneStop,
+			Adults:      2,
+			Children:    1,
+			InfantsLap:  1,
+			InfantsSeat: 1,
+		},
+	}, language.German, currency.EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := flights.Options{
+		Travelers: flights.Travelers{Adults: 2, Children: 1, InfantOnLap: 1, InfantInSeat: 1},
+		Currency:  currency.EUR,
+		Stops:     flights.Stop1,
+		Class:     flights.Business,
+		TripType:  flights.OneWay,
+		Lang:      language.German,
+	}
+	if options != expected {
+		t.Fatalf("expected %+v, got %+v", expected, options)
+	}
+
+	defaults, err := parseSearchOptions(searchOptionsParams{}, language.English, currency.USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if defaults != flights.OptionsDefault() {
+		t.Fatalf("expected the default options, got %+v", defaults)
+	}
+
+	nonstop, tooMany := 0, 3
+	if options, err := parseSearchOptions(searchOptionsParams{tripOptionsParams: tripOptionsParams{MaxStops: &nonstop}}, language.English, currency.USD); err != nil || options.Stops != flights.Nonstop {
+		t.Fatalf("maxStops 0 should search nonstop flights: %+v, %v", options, err)
+	}
+	for name, params := range map[string]searchOptionsParams{
+		"trip type":         {TripType: "multi-city"},
+		"class":             {tripOptionsParams: tripOptionsParams{Class: "coach"}},
+		"stops":             {tripOptionsParams: tripOptionsParams{MaxStops: &tooMany}},
+		"negative children": {tripOptionsParams: tripOptionsParams{Children: -1}},
+		"lap infants":       {tripOptionsParams: tripOptionsParams{Adults: 1, InfantsLap: 2}},
+		"too many":          {tripOptionsParams: tripOptionsParams{Adults: 5, Children: 5}},
+	} {
+		if _, err := parseSearchOptions(params, language.English, currency.USD); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSearchOptionsInSchema(t *testing.T) {
+	tools, err := connect(t, newTestServer(t, &fakeSession{})).ListTools(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The tools that only search round trips don't offer a tripType.
+	oneWay := map[string]bool{
+		"search_flights":        true,
+		"create_price_watch":    true,
+		"find_cheapest_offers":  false,
+		"get_price_calendar":    false,
+		"recommend_trip_length": false,
+	}
+	for _, tool := range tools.Tools {
+		hasTripType, ok := oneWay[tool.Name]
+		if !ok {
+			continue
+		}
+		schema, ok := tool.InputSchema.(map[string]any)
+		if !ok {
+			t.Fatalf("%s: unexpected input schema %#v", tool.Name, tool.InputSchema)
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for _, name := range []string{"class", "maxStops", "adults", "children", "infantsLap", "infantsSeat"} {
+			if _, ok := properties[name]; !ok {
+				t.Errorf("%s: %s is missing from the input schema", tool.Name, name)
+			}
+		}
+		if _, ok := properties["tripType"]; ok != hasTripType {
+			t.Errorf("%s: tripType in the input schema is %v, expected %v", tool.Name, ok, hasTripType)
+		}
+	}
+}
+
+func TestParseFilters(t *testing.T) {
+	filters, err := parseFilters(filterParams{MaxTotalDurationHours: 1.5, EarliestDeparture: "06:30", LatestDeparture: "21:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filters.MaxTotalDuration != 90*time.Minute || filters.EarliestDeparture != 6*time.Hour+30*time.Minute || filters.LatestDeparture != 21*time.Hour {
+		t.Fatalf("wrong filters: %+v", filters)
+	}
+	filters, err = parseFilters(filterParams{AvoidCountries: []string{"Russia", "tr"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(filters.AvoidCountries, []string{"RU", "TR"}) {
+		t.Fatalf("wrong countries: %v", filters.AvoidCountries)
+	}
+	for _, params := range []filterParams{
+		{AvoidCountries: []string{"Atlantis"}},
+		{AvoidCountries: []string{"Korea"}},
+		{EarliestDeparture: "6am"},
+		{MaxTotalDurationHours: -1},
+		{EarliestDeparture: "22:00", LatestDeparture: "06:00"},
+	} {
+		if _, err := parseFilters(params); err == nil {
+			t.Errorf("expected an error for %+v", params)
+		}
+	}
+}
+
+func TestParseCityLanguages(t *testing.T) {
+	langs, err := parseCityLanguages("en, de,it")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(langs, []language.Tag{language.English, language.German, language.Italian}) {
+		t.Fatalf("wrong city languages: %v", langs)
+	}
+	if langs, err := parseCityLanguages(""); err != nil || len(langs) != 0 {
+		t.Fatalf("expected no city languages, received: %v, %v", langs, err)
+	}
+	if _, err := parseCityLanguages("en,xx-!"); err == nil {
+		t.Fatal("expected an error for an invalid language")
+	}
+}
+
+func TestParseSelfTransferRisk(t *testing.T) {
+	for value, want := range map[string][2]bool{"": {false, false}, "flag": {false, false}, "derank": {true, false}, "exclude": {false, true}} {
+		derank, exclude, err := parseSelfTransferRisk(value)
+		if err != nil || [2]bool{derank, exclude} != want {
+			t.Errorf("%q: expected %v, got %v, %v, %v", value, want, derank, exclude, err)
+		}
+	}
+	if _, _, err := parseSelfTransferRisk("ignore"); err == nil {
+		t.Error("expected an error for an unknown value")
+	}
+}
+
+func TestParsePreferences(t *testing.T) {
+	preferences, err := parsePreferences(&preferencesParams{Price: 2, Stops: 400, DurationHours: 20, Departure: "09:30", DepartureHours: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := cheapoffers.Preferences{PerStop: 200, PerHour: 10, PreferredDeparture: 9*time.Hour + 30*time.Minute, PerDepartureHour: 5}
+	if preferences != want {
+		t.Fatalf("expected %+v, got %+v", want, preferences)
+	}
+	for _, params := range []*preferencesParams{
+		{Price: -1},
+		{Stops: -100},
+		{DepartureHours: 10},
+		{Departure: "9am", DepartureHours: 10},
+	} {
+		if _, err := parsePreferences(params); err == nil {
+			t.Errorf("expected an error for %+v", params)
+		}
+	}
+}
+
+func TestParseOriginPenalties(t *testing.T) {
+	penalties, err := parseOriginPenalties([]originPenaltyParam{{Airport: "BER", Penalty: 0}, {Airport: "HAM", Penalty: 80}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(penalties, map[string]float64{"BER": 0, "HAM": 80}) {
+		t.Fatalf("wrong penalties: %v", penalties)
+	}
+	for _, params := range [][]originPenaltyParam{
+		{{Airport: "Berlin", Penalty: 10}},
+		{{Airport: "HAM", Penalty: -5}},
+		{{Airport: "HAM", Penalty: 10}, {Airport: "HAM", Penalty: 20}},
+	} {
+		if _, err := parseOriginPenalties(params); err == nil {
+			t.Errorf("expected an error for %+v", params)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/notify"
+)
+
+// pipelineConfig is the format of --pipelines-file.
+type pipelineConfig struct {
+	Pipelines []*pipeline `json:"pipelines"`
+}
+
+// pipeline runs saved searches on a schedule, exports their offers as CSV and
+// posts a summary to chat.
+type pipeline struct {
+	Name      string             `json:"name"`
+	Weekday   string             `json:"weekday,omitempty"` // e.g. sunday, empty runs every day
+	Time      string             `json:"time"`              // HH:MM in UTC
+	Searches  []pipelineSearch   `json:"searches"`
+	ExportDir string             `json:"exportDir,omitempty"` // directory a CSV file of the offers of every run is written to
+	Notify    []pipelineNotifier `json:"notify,omitempty"`
+
+	weekday  *time.Weekday
+	at       time.Duration // Time as an offset from midnight
+	notifier notify.Multi
+}
+
+// pipelineSearch is a find_cheapest_offers call of a pipeline.
+type pipelineSearch struct {
+	Name   string                   `json:"name"`
+	Params findCheapestOffersParams `json:"params"`
+
+	// If WindowDays is set, the search window starts StartInDays days after the
+	// run and spans WindowDays days, replacing the range dates of Params.
+	StartInDays int `json:"startInDays,omitempty"`
+	WindowDays  int `json:"windowDays,omitempty"`
+}
+
+// pipelineNotifier is a chat destination of the summary of a pipeline. The
+// homeserver, access token and webhook URL may refer to a secret like the secret
+// flags do, e.g. file:/run/secrets/slack-webhook.
+type pipelineNotifier struct {
+	Type        string `json:"type"` // matrix or slack
+	Homeserver  string `json:"homeserver,omitempty"`
+	RoomID      string `json:"roomId,omitempty"`
+	AccessToken string `json:"accessToken,omitempty"`
+	WebhookURL  string `json:"webhookUrl,omitempty"`
+}
+
+// pipelineExportHeader is the header of the CSV files of the pipelines.
+var pipelineExportHeader = []string{"search", "id", "start_date", "return_date", "src_airport", "dst_airport", "price", "currency", "shareable_link"}
+
+// loadPipelines reads and validates a pipelines file.
+func loadPipelines(path string) ([]*pipeline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read pipelines: %w", err)
+	}
+	var file pipelineConfig
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse pipelines: %w", err)
+	}
+	names := map[string]bool{}
+	for _, p := range file.Pipelines {
+		if err := p.init(); err != nil {
+			return nil, fmt.Errorf("pipeline %q: %w", p.Name, err)
+		}
+		if names[p.Name] {
+			return nil, fmt.Errorf("pipeline %q is defined twice", p.Name)
+		}
+		names[p.Name] = true
+	}
+	return file.Pipelines, nil
+}
+
+// init validates the pipeline and sets up its schedule and notifiers.
+func (p *pipeline) init() error {
+	if p.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if strings.ContainsAny(p.Name, `/\`) {
+		return fmt.Errorf("name must not contain slashes, it names the export files")
+	}
+	if p.Weekday != "" {
+		weekday, ok := parseWeekday(p.Weekday)
+		if !ok {
+			return fmt.Errorf("invalid weekday %q, expected e.g. sunday", p.Weekday)
+		}
+		p.weekday = &weekday
+	}
+	if p.Time == "" {
+		return fmt.Errorf("time is required")
+	}
+	at, err := parseTimeOfDay("time", p.Time)
+	if err != nil {
+		return err
+	}
+	p.at = at
+	if len(p.Searches) == 0 {
+		return fmt.Errorf("at least one search is required")
+	}
+	for _, search := range p.Searches {
+		if search.Name == "" {
+			return fmt.Errorf("every search needs a name")
+		}
+		if search.StartInDays < 0 || search.WindowDays < 0 {
+			return fmt.Errorf("search %q: startInDays and windowDays must not be negative", search.Name)
+		}
+	}
+	if p.ExportDir == "" && len(p.Notify) == 0 {
+		return fmt.Errorf("exportDir or notify is required, the pipeline has no output")
+	}
+	for _, n := range p.Notify {
+		notifier, err := n.notifier()
+		if err != nil {
+			return err
+		}
+		p.notifier = append(p.notifier, notifier)
+	}
+	return nil
+}
+
+func (n pipelineNotifier) notifier() (notify.Notifier, error) {
+	switch n.Type {
+	case "matrix":
+		homeserver, err := resolveSecret(n.Homeserver)
+		if err != nil {
+			return nil, fmt.Errorf("resolve matrix homeserver: %w", err)
+		}
+		token, err := resolveSecret(n.AccessToken)
+		if err != nil {
+			return nil, fmt.Errorf("resolve matrix access token: %w", err)
+		}
+		if homeserver == "" || n.RoomID == "" || token == "" {
+			return nil, fmt.Errorf("matrix: homeserver, roomId and accessToken are required")
+		}
+		return &notify.Matrix{Homeserver: homeserver, RoomID: n.RoomID, AccessToken: token}, nil
+	case "slack":
+		webhook, err := resolveSecret(n.WebhookURL)
+		if err != nil {
+			return nil, fmt.Errorf("resolve slack webhook URL: %w", err)
+		}
+		if webhook == "" {
+			return nil, fmt.Errorf("slack: webhookUrl is required")
+		}
+		return &notify.Slack{WebhookURL: webhook}, nil
+	}
+	return nil, fmt.Errorf("invalid notifier type %q, expected matrix or slack", n.Type)
+}
+
+func parseWeekday(value string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(value, d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// next returns the first scheduled run of the pipeline after after.
+func (p *pipeline) next(after time.Time) time.Time {
+	after = after.UTC()
+	run := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, time.UTC).Add(p.at)
+	for !run.After(after) || p.weekday != nil && run.Weekday() != *p.weekday {
+		run = run.AddDate(0, 0, 1)
+	}
+	return run
+}
+
+// runPipelines runs every pipeline on its schedule until ctx is done.
+func (s *server) runPipelines(ctx context.Context, pipelines []*pipeline) {
+	for _, p := range pipelines {
+		go func() {
+			for {
+				timer := time.NewTimer(time.Until(p.next(time.Now())))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+				if err := s.runPipeline(backgroundContext(ctx, "pipeline "+p.Name), p, time.Now()); err != nil {
+					log.Printf("pipeline %s: %v", p.Name, err)
+				}
+			}
+		}()
+	}
+}
+
+// runPipeline runs the searches of p, exports their offers and posts the summary.
+// A failed search doesn't stop the others, it's reported in the summary.
+func (s *server) runPipeline(ctx context.Context, p *pipeline, now time.Time) error {
+	var (
+		errs  []error
+		rows  [][]string
+		lines []string
+	)
+	for _, search := range p.Searches {
+		params := search.Params
+		if search.WindowDays > 0 {
+			start := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, search.StartInDays)
+			params.RangeStartDate = start.Format(time.DateOnly)
+			params.RangeEndDate = start.AddDate(0, 0, search.WindowDays-1).Format(time.DateOnly)
+		}
+		_, response, err := s.findCheapestOffers(ctx, nil, params)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("search %s: %w", search.Name, err))
+			lines = append(lines, fmt.Sprintf("%s: failed: %v", search.Name, err))
+			continue
+		}
+		for _, o := range response.Offers {
+			rows = append(rows, []string{search.Name, o.ID, o.StartDate, o.ReturnDate, o.SrcAirport, o.DstAirport,
+				s.formatPrice(o.Price), o.Currency, o.ShareableLink})
+		}
+		if len(response.Offers) == 0 {
+			lines = append(lines, fmt.Sprintf("%s: no offers", search.Name))
+			continue
+		}
+		cheapest := response.Offers[0]
+		lines = append(lines, fmt.Sprintf("%s: %d offer(s), cheapest %s %s %s -> %s on %s",
+			search.Name, len(response.Offers), s.formatPrice(cheapest.Price), cheapest.Currency,
+			cheapest.SrcAirport, cheapest.DstAirport, cheapest.StartDate))
+	}
+
+	if p.ExportDir != "" {
+		path, err := exportPipelineRun(p, now, rows)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			lines = append(lines, "Exported to "+path)
+		}
+	}
+	if len(p.notifier) > 0 {
+		msg := notify.Message{Title: "Pipeline " + p.Name, Body: strings.Join(lines, "\n")}
+		if err := p.notifier.Notify(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("notify: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// exportPipelineRun writes the offers of a run to a new CSV file in the export
+// directory of p and returns its path.
+func exportPipelineRun(p *pipeline, now time.Time, rows [][]string) (string, error) {
+	if err := os.MkdirAll(p.ExportDir, 0o755); err != nil {
+		return "", fmt.Errorf("create export directory: %w", err)
+	}
+	path := filepath.Join(p.ExportDir, fmt.Sprintf("%s-%s.csv", p.Name, now.UTC().Format("20060102T1504Z")))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("create export: %w", err)
+	}
+	if err := writeOutput(f, "csv", nil, pipelineExportHeader, rows); err != nil {
+		f.Close()
+		return "", fmt.Errorf("write export: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("write export: %w", err)
+	}
+	return path, nil
+}
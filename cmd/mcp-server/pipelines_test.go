@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/notify"
+)
+
+type recordingNotifier struct {
+	messages []notify.Message
+}
+
+func (r *recordingNotifier) Notify(ctx context.Context, msg notify.Message) error {
+	r.messages = append(r.messages, msg)
+	return nil
+}
+
+func TestLoadPipelines(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("SLACK_WEBHOOK", "https://hooks.slack.com/services/T000/B000/XXXX")
+	write := func(config string) string {
+		path := filepath.Join(dir, "pipelines.json")
+		if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	pipelines, err := loadPipelines(write(`{"pipelines": [{
+		"name": "weekly", "weekday": "Sunday", "time": "08:30",
+		"searches": [{"name": "nyc", "params": {"srcCities": ["San Francisco"], "dstCities": ["New York"], "tripLengths": [7]}, "windowDays": 30}],
+		"exportDir": "/tmp/exports",
+		"notify": [{"type": "slack", "webhookUrl": "env:SLACK_WEBHOOK"}]
+	}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := pipelines[0]
+	if p.weekday == nil || *p.weekday != time.Sunday || p.at != 8*time.Hour+30*time.Minute || len(p.notifier) != 1 {
+		t.Fatalf("wrong pipeline: %+v", p)
+	}
+	if slack, ok := p.notifier[0].(*notify.Slack); !ok || !strings.HasPrefix(slack.WebhookURL, "https://hooks.slack.com/") {
+		t.Fatalf("expected the resolved slack webhook, got %#v", p.notifier[0])
+	}
+	if got := p.Searches[0].Params.DstCities; len(got) != 1 || got[0] != "New York" {
+		t.Fatalf("wrong search params: %+v", p.Searches[0].Params)
+	}
+
+	for _, config := range []string{
+		`{"pipelines": [{"time": "08:00", "searches": [{"name": "a"}], "exportDir": "x"}]}`,
+		`{"pipelines": [{"name": "a", "weekday": "someday", "time": "08:00", "searches": [{"name": "a"}], "exportDir": "x"}]}`,
+		`{"pipelines": [{"name": "a", "time": "8am", "searches": [{"name": "a"}], "exportDir": "x"}]}`,
+		`{"pipelines": [{"name": "a", "time": "08:00", "searches": [], "exportDir": "x"}]}`,
+		`{"pipelines": [{"name": "a", "time": "08:00", "searches": [{"name": "a"}]}]}`,
+		`{"pipelines": [{"name": "a", "time": "08:00", "searches": [{"name": "a"}], "notify": [{"type": "email"}]}]}`,
+		`{"pipelines": [{"name": "a/b", "time": "08:00", "searches": [{"name": "a"}], "exportDir": "x"}]}`,
+		`{"pipelines": [{"name": "a", "time": "08:00", "searches": [{"name": "a"}], "exportDir": "x"}, {"name": "a", "time": "09:00", "searches": [{"name": "a"}], "exportDir": "x"}]}`,
+	} {
+		if _, err := loadPipelines(write(config)); err == nil {
+			t.Errorf("expected an error for %s", config)
+		}
+	}
+}
+
+func TestPipelineNext(t *testing.T) {
+	sunday := time.Sunday
+	weekly := &pipeline{weekday: &sunday, at: 8 * time.Hour}
+	daily := &pipeline{at: 8 * time.Hour}
+	// 2026-01-07 is a Wednesday.
+	wednesday := time.Date(2026, 1, 7, 9, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		p     *pipeline
+		after time.Time
+		want  time.Time
+	}{
+		{weekly, wednesday, time.Date(2026, 1, 11, 8, 0, 0, 0, time.UTC)},
+		{weekly, time.Date(2026, 1, 11, 8, 0, 0, 0, time.UTC), time.Date(2026, 1, 18, 8, 0, 0, 0, time.UTC)},
+		{daily, wednesday, time.Date(2026, 1, 8, 8, 0, 0, 0, time.UTC)},
+		{daily, time.Date(2026, 1, 7, 7, 0, 0, 0, time.UTC), time.Date(2026, 1, 7, 8, 0, 0, 0, time.UTC)},
+	} {
+		if got := tt.p.next(tt.after); !got.Equal(tt.want) {
+			t.Errorf("next after %s: expected %s, got %s", tt.after, tt.want, got)
+		}
+	}
+}
+
+func TestRunPipeline(t *testing.T) {
+	s := newTestServer(t, conformanceBackend())
+	recorder := &recordingNotifier{}
+	p := &pipeline{
+		Name: "weekly",
+		Searches: []pipelineSearch{
+			{Name: "nyc", StartInDays: 20, WindowDays: 20, Params: findCheapestOffersParams{
+				TripLengths: []int{7}, SrcCities: []string{"San Francisco"}, DstCities: []string{"New York"},
+			}},
+			{Name: "invalid", Params: findCheapestOffersParams{TripLengths: []int{7}}},
+		},
+		ExportDir: t.TempDir(),
+		notifier:  notify.Multi{recorder},
+	}
+	now := time.Now()
+
+	err := s.runPipeline(context.Background(), p, now)
+	if err == nil || !strings.Contains(err.Error(), "search invalid") {
+		t.Fatalf("expected the invalid search to fail, got %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(p.ExportDir, "weekly-*.csv"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one export, got %v, %v", files, err)
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) < 2 || strings.Join(records[0], ",") != strings.Join(pipelineExportHeader, ",") || records[1][0] != "nyc" {
+		t.Fatalf("wrong export: %v", records)
+	}
+
+	if len(recorder.messages) != 1 {
+		t.Fatalf("expected one summary, got %d", len(recorder.messages))
+	}
+	body := recorder.messages[0].Body
+	for _, want := range []string{"nyc: ", "offer(s), cheapest 100 USD", "invalid: failed", "Exported to " + files[0]} {
+		if !strings.Contains(body, want) {
+			t.Errorf("summary doesn't contain %q:\n%s", want, body)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/iata"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// minConnection is the shortest layover prepare_booking doesn't warn about.
+const minConnection = time.Hour
+
+type prepareBookingParams struct {
+	OfferID string `json:"offerId" jsonschema:"ID of an offer returned by find_cheapest_offers or search_flights"`
+}
+
+type bookingFlightResponse struct {
+	flightResponse
+	DepAirportName string `json:"depAirportName,omitempty"`
+	ArrAirportName string `json:"arrAirportName,omitempty"`
+	DepTimeZone    string `json:"depTimeZone"` // IANA time zone of depTime
+	ArrTimeZone    string `json:"arrTimeZone"` // IANA time zone of arrTime
+}
+
+type prepareBookingResponse struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	OfferID       string `json:"offerId"`
+	Available     bool   `json:"available"` // the flights of the offer are still offered
+	ShareableLink string `json:"shareableLink"`
+	StartDate     string `json:"startDate"`
+	ReturnDate    string `json:"returnDate"`
+	SrcAirport    string `json:"srcAirport"`
+	DstAirport    string `json:"dstAirport"`
+	SrcCity       string `json:"srcCity,omitempty"` // in the language of the search
+	DstCity       string `json:"dstCity,omitempty"`
+
+	Flights          []bookingFlightResponse `json:"flights"` // outbound flights, times local to their airports
+	Travelers        int                     `json:"travelers"`
+	Total            float64                 `json:"total,omitempty"` // price for all travelers
+	PricePerTraveler float64                 `json:"pricePerTraveler,omitempty"`
+	Currency         string                  `json:"currency"`
+	PriceChange      float64                 `json:"priceChange,omitempty"` // total minus the price the offer was returned with
+
+	Caveats   []string `json:"caveats"`            // what to double-check before paying
+	Checklist []string `json:"checklist"`          // steps to book the offer, in order
+	Calendar  string   `json:"calendar,omitempty"` // iCalendar file with the outbound flights, also embedded as a resource
+
+	Source    string `json:"source"` // always live
+	CheckedAt string `json:"checkedAt"`
+}
+
+func (s *server) prepareBooking(ctx context.Context, _ *mcp.CallToolRequest, params prepareBookingParams) (*mcp.CallToolResult, prepareBookingResponse, error) {
+	registered, ok, err := s.store.Offer(ctx, params.OfferID)
+	if err != nil {
+		return nil, prepareBookingResponse{}, fmt.Errorf("load offer: %w", err)
+	}
+	if !ok {
+		return nil, prepareBookingResponse{}, fmt.Errorf("unknown offerId %q, it may have expired; search again", params.OfferID)
+	}
+	args := registered.Args
+	if err := s.routePolicy.Check(args.SrcAirports, args.DstAirports); err != nil {
+		return nil, prepareBookingResponse{}, err
+	}
+
+	offers, priceRange, err := s.session.GetOffers(ctx, args)
+	if err != nil {
+		return nil, prepareBookingResponse{}, err
+	}
+	link, err := s.session.SerializeURL(ctx, args)
+	if err != nil {
+		return nil, prepareBookingResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	response := prepareBookingResponse{
+		SchemaVersion: s.schemaVersion,
+		OfferID:       params.OfferID,
+		ShareableLink: link,
+		StartDate:     args.Date.Format(time.DateOnly),
+		ReturnDate:    args.ReturnDate.Format(time.DateOnly),
+		SrcAirport:    args.SrcAirports[0],
+		DstAirport:    args.DstAirports[0],
+		SrcCity:       iata.CityName(args.SrcAirports[0], args.Lang),
+		DstCity:       iata.CityName(args.DstAirports[0], args.Lang),
+		Flights:       []bookingFlightResponse{},
+		Travelers:     travelers(args.Travelers),
+		Currency:      args.Currency.String(),
+		Caveats:       []string{},
+		Source:        sourceLive,
+		CheckedAt:     now.Format(time.RFC3339),
+	}
+
+	var offer flights.FullOffer
+	for _, o := range offers {
+		if o.Price > 0 && cheapoffers.FullOfferID(o) == params.OfferID {
+			offer, response.Available = o, true
+			break
+		}
+	}
+	if !response.Available {
+		response.Caveats = append(response.Caveats, "The flights of the offer aren't offered anymore; pick another offer.")
+		response.Checklist = []string{"Search again or pick another offer, then call prepare_booking with its offerId."}
+		result := &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("The flights of offer %s aren't offered anymore for %s -> %s on %s.",
+					params.OfferID, response.SrcAirport, response.DstAirport, response.StartDate)},
+			},
+		}
+		return result, response, nil
+	}
+
+	response.Total = s.roundPrice(offer.Price)
+	response.PricePerTraveler = s.roundPrice(offer.Price / float64(response.Travelers))
+	if registered.Price > 0 {
+		response.PriceChange = response.Total - s.roundPrice(registered.Price)
+	}
+	for _, f := range offer.Flight {
+		response.Flights = append(response.Flights, bookingFlightResponse{
+			flightResponse: flightResponse{
+				FlightNumber:    f.FlightNumber,
+				AirlineName:     f.AirlineName,
+				DepAirport:      f.DepAirportCode,
+				ArrAirport:      f.ArrAirportCode,
+				DepTime:         f.DepTime.Format(time.RFC3339),
+				ArrTime:         f.ArrTime.Format(time.RFC3339),
+				DurationMinutes: int(f.Duration.Minutes()),
+				Airplane:        f.Airplane,
+			},
+			DepAirportName: f.DepAirportName,
+			ArrAirportName: f.ArrAirportName,
+			DepTimeZone:    f.DepTime.Location().String(),
+			ArrTimeZone:    f.ArrTime.Location().String(),
+		})
+	}
+	response.Caveats = append(response.Caveats, s.bookingCaveats(offer, response, priceRange)...)
+	response.Checklist = bookingChecklist(response, s.formatPrice(response.Total))
+	response.Calendar = bookingCalendar(params.OfferID, offer, link, now)
+
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("Booking %s -> %s on %s for %s %s, %d traveler(s):",
+		response.SrcAirport, response.DstAirport, response.StartDate, s.formatPrice(response.Total), response.Currency, response.Travelers))
+	for i, step := range response.Checklist {
+		summary.WriteString(fmt.Sprintf("\n%d. %s", i+1, step))
+	}
+	if len(response.Caveats) > 0 {
+		summary.WriteString("\nCaveats:")
+		for _, c := range response.Caveats {
+			summary.WriteString("\n- " + c)
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+			&mcp.EmbeddedResource{Resource: &mcp.ResourceContents{
+				URI:      "booking://" + params.OfferID + "/flights.ics",
+				MIMEType: "text/calendar",
+				Text:     response.Calendar,
+			}},
+		},
+	}
+	return result, response, nil
+}
+
+func travelers(t flights.Travelers) int {
+	return max(t.Adults+t.Children+t.InfantInSeat+t.InfantOnLap, 1)
+}
+
+// bookingCaveats lists what the offer's data suggests double-checking before paying.
+func (s *server) bookingCaveats(offer flights.FullOffer, response prepareBookingResponse, priceRange *flights.PriceRange) []string {
+	var caveats []string
+	if response.PriceChange > 0 {
+		caveats = append(caveats, fmt.Sprintf("The price went up by %s %s since the offer was found.", s.formatPrice(response.PriceChange), response.Currency))
+	}
+	for i := 0; i+1 < len(offer.Flight); i++ {
+		arrival, departure := offer.Flight[i], offer.Flight[i+1]
+		if arrival.ArrAirportCode != departure.DepAirportCode {
+			caveats = append(caveats, fmt.Sprintf("The connection changes airports from %s to %s; allow for the transfer.", arrival.ArrAirportCode, departure.DepAirportCode))
+		}
+		if layover := departure.DepTime.Sub(arrival.ArrTime); layover < minConnection {
+			caveats = append(caveats, fmt.Sprintf("The layover in %s is only %d minutes.", arrival.ArrAirportCode, int(layover.Minutes())))
+		}
+	}
+	if cheapoffers.OvernightLayover(offer) {
+		caveats = append(caveats, "An outbound layover covers the local night; check the terminal's opening hours or book a hotel.")
+	}
+	if n := len(offer.Flight); n > 0 {
+		dep, arr := offer.Flight[0].DepTime, offer.Flight[n-1].ArrTime
+		if days := calendarDays(dep, arr); days > 0 {
+			caveats = append(caveats, fmt.Sprintf("The outbound trip arrives %d day(s) after it departs, local time.", days))
+		}
+	}
+	if priceRange != nil && priceRange.Insight.Level == flights.PriceLevelHigh {
+		caveats = append(caveats, "Google considers the current prices of the route high.")
+	}
+	if response.Travelers > 1 {
+		caveats = append(caveats, "The total is for all travelers; check that the booking site shows the same party.")
+	}
+	caveats = append(caveats, "Only the outbound flights are known; pick the return flights on Google Flights.",
+		"Baggage allowance and fare conditions aren't known; check them before paying.")
+	return caveats
+}
+
+// calendarDays returns the number of calendar days between the local dates of
+// dep and arr.
+func calendarDays(dep, arr time.Time) int {
+	depDay := time.Date(dep.Year(), dep.Month(), dep.Day(), 0, 0, 0, 0, time.UTC)
+	arrDay := time.Date(arr.Year(), arr.Month(), arr.Day(), 0, 0, 0, 0, time.UTC)
+	return int(arrDay.Sub(depDay).Hours() / 24)
+}
+
+func bookingChecklist(response prepareBookingResponse, total string) []string {
+	// Google may report an offer without its flights, which leaves only its date
+	// to pick it by.
+	outbound := fmt.Sprintf("Select the outbound flights for %s -> %s on %s.", response.SrcAirport, response.DstAirport, response.StartDate)
+	if len(response.Flights) > 0 {
+		var numbers []string
+		for _, f := range response.Flights {
+			numbers = append(numbers, f.FlightNumber)
+		}
+		outbound = fmt.Sprintf("Select the outbound flights %s departing %s.", strings.Join(numbers, " / "), response.Flights[0].DepTime)
+	}
+	checklist := []string{
+		"Open the shareable link: " + response.ShareableLink,
+		outbound,
+		fmt.Sprintf("Select the return flights on %s.", response.ReturnDate),
+		fmt.Sprintf("Check that the total is %s %s for %d traveler(s).", total, response.Currency, response.Travelers),
+		"Enter every traveler's name exactly as in their passport.",
+	}
+	if len(response.Flights) > 0 {
+		checklist = append(checklist, "Add the flights to your calendar with the attached iCalendar file.")
+	}
+	return checklist
+}
+
+// bookingCalendar returns an iCalendar file with an event per flight.
+func bookingCalendar(offerID string, offer flights.FullOffer, link string, now time.Time) string {
+	const stamp = "20060102T150405Z"
+	var b strings.Builder
+	line := func(s string) {
+		// Lines are folded after 75 octets, see RFC 5545 section 3.1.
+		for len(s) > 75 {
+			b.WriteString(s[:75] + "\r\n")
+			s = " " + s[75:]
+		}
+		b.WriteString(s + "\r\n")
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//" + serverName + "//prepare_booking//EN")
+	for i, f := range offer.Flight {
+		line("BEGIN:VEVENT")
+		line(fmt.Sprintf("UID:%s-%d@%s", offerID, i, serverName))
+		line("DTSTAMP:" + now.UTC().Format(stamp))
+		line("DTSTART:" + f.DepTime.UTC().Format(stamp))
+		line("DTEND:" + f.ArrTime.UTC().Format(stamp))
+		line("SUMMARY:" + icsText(fmt.Sprintf("%s %s -> %s", f.FlightNumber, f.DepAirportCode, f.ArrAirportCode)))
+		if f.DepAirportName != "" {
+			line("LOCATION:" + icsText(f.DepAirportName))
+		}
+		line("DESCRIPTION:" + icsText(fmt.Sprintf("%s, departs %s local time, arrives %s local time.\nBook: %s",
+			f.AirlineName, f.DepTime.Format("2006-01-02 15:04"), f.ArrTime.Format("2006-01-02 15:04"), link)))
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return b.String()
+}
+
+// icsText escapes a TEXT value of an iCalendar property.
+func icsText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestPrepareBooking(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	dep := time.Date(2026, 6, 1, 18, 0, 0, 0, newYork)
+	offer := flights.FullOffer{
+		Offer:          flights.Offer{StartDate: start, ReturnDate: end, Price: 1000},
+		SrcAirportCode: "JFK",
+		DstAirportCode: "BER",
+		Flight: []flights.Flight{
+			{DepAirportCode: "JFK", DepAirportName: "John F. Kennedy, New York", ArrAirportCode: "LHR", DepTime: dep,
+				ArrTime: dep.Add(7 * time.Hour).In(berlin), Duration: 7 * time.Hour, FlightNumber: "BA 178", AirlineName: "British Airways"},
+			{DepAirportCode: "LGW", ArrAirportCode: "BER", DepTime: dep.Add(7*time.Hour + 45*time.Minute).In(berlin),
+				ArrTime: dep.Add(10 * time.Hour).In(berlin), Duration: 2 * time.Hour, FlightNumber: "BA 2000", AirlineName: "British Airways"},
+		},
+	}
+	id := cheapoffers.FullOfferID(offer)
+
+	s := newTestServer(t, &fakeSession{
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			other := offer
+			other.Flight = offer.Flight[:1]
+			return []flights.FullOffer{other, offer}, &flights.PriceRange{Low: 800, High: 1200}, nil
+		},
+	})
+	args := flights.Args{
+		Date:        start,
+		ReturnDate:  end,
+		SrcAirports: []string{"JFK"},
+		DstAirports: []string{"BER"},
+		Options:     flights.OptionsDefault(),
+	}
+	args.Travelers = flights.Travelers{Adults: 2}
+	if err := s.store.SaveOffer(context.Background(), id, registeredOffer{Args: args, Price: 950}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, response, err := s.prepareBooking(context.Background(), nil, prepareBookingParams{OfferID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Available || response.Total != 1000 || response.PricePerTraveler != 500 || response.Travelers != 2 || response.PriceChange != 50 {
+		t.Fatalf("wrong prices: %+v", response)
+	}
+	if len(response.Flights) != 2 || response.Flights[0].DepTime != "2026-06-01T18:00:00-04:00" ||
+		response.Flights[0].DepTimeZone != "America/New_York" || response.Flights[1].ArrTimeZone != "Europe/Berlin" {
+		t.Fatalf("wrong flights: %+v", response.Flights)
+	}
+	for _, caveat := range []string{"went up by 50", "from LHR to LGW", "only 45 minutes", "arrives 1 day(s) after", "for all travelers"} {
+		if !strings.Contains(strings.Join(response.Caveats, "\n"), caveat) {
+			t.Errorf("expected a caveat containing %q, got %q", caveat, response.Caveats)
+		}
+	}
+	if !strings.Contains(response.Checklist[0], response.ShareableLink) {
+		t.Fatalf("the checklist doesn't start with the link: %q", response.Checklist)
+	}
+
+	for _, want := range []string{"BEGIN:VCALENDAR\r\n", "DTSTART:20260601T220000Z\r\n", "SUMMARY:BA 178 JFK -> LHR\r\n", `LOCATION:John F. Kennedy\, New York`} {
+		if !strings.Contains(response.Calendar, want) {
+			t.Errorf("calendar doesn't contain %q:\n%s", want, response.Calendar)
+		}
+	}
+	for _, line := range strings.Split(response.Calendar, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("calendar line longer than 75 octets: %q", line)
+		}
+	}
+	if len(result.Content) != 2 {
+		t.Fatalf("expected a summary and the calendar, got %d contents", len(result.Content))
+	}
+	if resource, ok := result.Content[1].(*mcp.EmbeddedResource); !ok || resource.Resource.MIMEType != "text/calendar" {
+		t.Fatalf("expected the calendar as a resource, got %#v", result.Content[1])
+	}
+}
+
+func TestPrepareBookingWithoutFlights(t *testing.T) {
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	offer := flights.FullOffer{
+		Offer:          flights.Offer{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: 1000},
+		SrcAirportCode: "JFK",
+		DstAirportCode: "BER",
+	}
+	id := cheapoffers.FullOfferID(offer)
+	s := newTestServer(t, &fakeSession{
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			return []flights.FullOffer{offer}, nil, nil
+		},
+	})
+	args := flights.Args{Date: start, ReturnDate: start.AddDate(0, 0, 7), SrcAirports: []string{"JFK"}, DstAirports: []string{"BER"}}
+	if err := s.store.SaveOffer(context.Background(), id, registeredOffer{Args: args, Price: 1000}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, response, err := s.prepareBooking(context.Background(), nil, prepareBookingParams{OfferID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !response.Available || len(response.Flights) != 0 {
+		t.Fatalf("expected an available offer without flights, got %+v", response)
+	}
+	if step := response.Checklist[1]; step != "Select the outbound flights for JFK -> BER on 2026-06-01." {
+		t.Fatalf("wrong outbound step: %q", step)
+	}
+	for _, step := range response.Checklist {
+		if strings.Contains(step, "calendar") {
+			t.Fatalf("expected no calendar step without flights, got %q", step)
+		}
+	}
+}
+
+func TestPrepareBookingUnavailable(t *testing.T) {
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	s := newTestServer(t, &fakeSession{})
+	args := flights.Args{Date: start, ReturnDate: start.AddDate(0, 0, 7), SrcAirports: []string{"JFK"}, DstAirports: []string{"BER"}}
+	if err := s.store.SaveOffer(context.Background(), "gone", registeredOffer{Args: args, Price: 950}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, response, err := s.prepareBooking(context.Background(), nil, prepareBookingParams{OfferID: "gone"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Available || response.Calendar != "" || len(response.Caveats) != 1 {
+		t.Fatalf("expected an unavailable offer, got %+v", response)
+	}
+
+	if _, _, err := s.prepareBooking(context.Background(), nil, prepareBookingParams{OfferID: "unknown"}); err == nil {
+		t.Fatal("expected an error for an unknown offer")
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type priceByAirlineParams struct {
+	RangeStartDate string   `json:"rangeStartDate" jsonschema:"Earliest departure date to consider (YYYY-MM-DD)"`
+	RangeEndDate   string   `json:"rangeEndDate" jsonschema:"Last departure date to consider (YYYY-MM-DD), at most 30 days after rangeStartDate"`
+	TripLength     int      `json:"tripLength" jsonschema:"Trip length in days"`
+	SrcCities      []string `json:"srcCities" jsonschema:"City names accepted by Google Flights"`
+	DstCities      []string `json:"dstCities" jsonschema:"Destination city names accepted by Google Flights"`
+	Language       string   `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency       string   `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	Adults         int      `json:"adults,omitempty" jsonschema:"Optional number of adult travelers, defaults to 1"`
+}
+
+type airlinePriceResponse struct {
+	AirlineCode string  `json:"airlineCode"`
+	AirlineName string  `json:"airlineName"`
+	Price       float64 `json:"price"`
+	Premium     float64 `json:"premium"` // difference to the cheapest fare of any airline
+	Currency    string  `json:"currency"`
+	StartDate   string  `json:"startDate"`
+	ReturnDate  string  `json:"returnDate"`
+	SrcAirport  string  `json:"srcAirport"`
+	DstAirport  string  `json:"dstAirport"`
+	Stops       int     `json:"stops"`
+}
+
+type priceByAirlineResponse struct {
+	SchemaVersion int                    `json:"schemaVersion"`
+	Market        string                 `json:"market"` // country of sale the prices apply to, "auto" if derived from the server's IP address
+	Airlines      []airlinePriceResponse `json:"airlines"`
+}
+
+func (s *server) priceByAirline(ctx context.Context, _ *mcp.CallToolRequest, params priceByAirlineParams) (*mcp.CallToolResult, priceByAirlineResponse, error) {
+	startDate, err := parseDate("rangeStartDate", params.RangeStartDate)
+	if err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+	endDate, err := parseDate("rangeEndDate", params.RangeEndDate)
+	if err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+	if err := s.routePolicy.Check(params.SrcCities, params.DstCities); err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+	adults, err := parseAdults(params.Adults)
+	if err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+
+	prices, err := cheapoffers.CheapestByAirline(
+		ctx,
+		s.session,
+		cheapoffers.AirlineArgs{
+			RangeStartDate: startDate,
+			RangeEndDate:   endDate,
+			TripLength:     params.TripLength,
+			SrcCities:      params.SrcCities,
+			DstCities:      params.DstCities,
+			Options: flights.Options{
+				Travelers: flights.Travelers{Adults: adults},
+				Currency:  curr,
+				Stops:     flights.AnyStops,
+				Class:     flights.Economy,
+				TripType:  flights.RoundTrip,
+				Lang:      lang,
+			},
+		},
+	)
+	if err != nil {
+		return nil, priceByAirlineResponse{}, err
+	}
+
+	response := priceByAirlineResponse{SchemaVersion: s.schemaVersion, Market: s.marketName(), Airlines: make([]airlinePriceResponse, 0, len(prices))}
+	for _, p := range prices {
+		response.Airlines = append(response.Airlines, airlinePriceResponse{
+			AirlineCode: p.AirlineCode,
+			AirlineName: p.AirlineName,
+			Price:       s.roundPrice(p.Price),
+			Premium:     s.roundPrice(p.Price) - s.roundPrice(prices[0].Price),
+			Currency:    curr.String(),
+			StartDate:   p.StartDate.Format(time.RFC3339),
+			ReturnDate:  p.ReturnDate.Format(time.RFC3339),
+			SrcAirport:  p.SrcAirport,
+			DstAirport:  p.DstAirport,
+			Stops:       p.Stops,
+		})
+	}
+
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("Found fares from %d airline(s).", len(response.Airlines)))
+	for _, a := range response.Airlines {
+		summary.WriteString(fmt.Sprintf("\n%s (%s): %s %s", a.AirlineName, a.AirlineCode, s.formatPrice(a.Price), a.Currency))
+		if a.Premium > 0 {
+			summary.WriteString(fmt.Sprintf(" (+%s)", s.formatPrice(a.Premium)))
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
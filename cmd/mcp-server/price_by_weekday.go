@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type priceByWeekdayParams struct {
+	RangeStartDate string   `json:"rangeStartDate" jsonschema:"Earliest departure date to consider (YYYY-MM-DD)"`
+	RangeEndDate   string   `json:"rangeEndDate" jsonschema:"Last departure date to consider (YYYY-MM-DD)"`
+	TripLength     int      `json:"tripLength" jsonschema:"Trip length in days"`
+	SrcCities      []string `json:"srcCities" jsonschema:"City names accepted by Google Flights"`
+	DstCities      []string `json:"dstCities" jsonschema:"Destination city names accepted by Google Flights"`
+	Language       string   `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency       string   `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	Adults         int      `json:"adults,omitempty" jsonschema:"Optional number of adult travelers, defaults to 1"`
+}
+
+type weekdayPriceResponse struct {
+	Weekday      string  `json:"weekday"`
+	Samples      int     `json:"samples"`
+	AveragePrice float64 `json:"averagePrice"`
+	MinPrice     float64 `json:"minPrice"`
+	MinDate      string  `json:"minDate,omitempty"`
+}
+
+type priceByWeekdayResponse struct {
+	SchemaVersion   int                    `json:"schemaVersion"`
+	Route           string                 `json:"route"`  // in the requested language, e.g. "München -> New York"
+	Market          string                 `json:"market"` // country of sale the prices apply to, "auto" if derived from the server's IP address
+	Currency        string                 `json:"currency"`
+	Weekdays        []weekdayPriceResponse `json:"weekdays"`
+	CheapestWeekday string                 `json:"cheapestWeekday,omitempty"` // weekday with the lowest average price
+}
+
+func (s *server) priceByWeekday(ctx context.Context, _ *mcp.CallToolRequest, params priceByWeekdayParams) (*mcp.CallToolResult, priceByWeekdayResponse, error) {
+	startDate, err := parseDate("rangeStartDate", params.RangeStartDate)
+	if err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+	endDate, err := parseDate("rangeEndDate", params.RangeEndDate)
+	if err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+	if params.TripLength <= 0 {
+		return nil, priceByWeekdayResponse{}, fmt.Errorf("tripLength must be positive")
+	}
+	if err := s.routePolicy.Check(params.SrcCities, params.DstCities); err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+	adults, err := parseAdults(params.Adults)
+	if err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+
+	offers, err := s.session.GetPriceGraph(
+		ctx,
+		flights.PriceGraphArgs{
+			RangeStartDate: startDate,
+			RangeEndDate:   endDate,
+			TripLength:     params.TripLength,
+			SrcCities:      params.SrcCities,
+			DstCities:      params.DstCities,
+			Options: flights.Options{
+				Travelers: flights.Travelers{Adults: adults},
+				Currency:  curr,
+				Stops:     flights.AnyStops,
+				Class:     flights.Economy,
+				TripType:  flights.RoundTrip,
+				Lang:      lang,
+			},
+		},
+	)
+	if err != nil {
+		return nil, priceByWeekdayResponse{}, err
+	}
+
+	response := priceByWeekdayResponse{
+		SchemaVersion: s.schemaVersion,
+		Route:         routeName(params.SrcCities, nil, params.DstCities, nil, lang),
+		Market:        s.marketName(),
+		Currency:      curr.String(),
+		Weekdays:      make([]weekdayPriceResponse, 0, 7),
+	}
+	var cheapest *cheapoffers.WeekdayStats
+	stats := cheapoffers.PricesByWeekday(offers)
+	for i, st := range stats {
+		weekday := weekdayPriceResponse{
+			Weekday:      st.Weekday.String(),
+			Samples:      st.Samples,
+			AveragePrice: s.roundPrice(st.AveragePrice),
+			MinPrice:     s.roundPrice(st.MinPrice),
+		}
+		if st.Samples > 0 {
+			weekday.MinDate = st.MinDate.Format(time.DateOnly)
+			if cheapest == nil || st.AveragePrice < cheapest.AveragePrice {
+				cheapest = &stats[i]
+			}
+		}
+		response.Weekdays = append(response.Weekdays, weekday)
+	}
+
+	var summary strings.Builder
+	if cheapest == nil {
+		summary.WriteString("The price graph returned no prices for this window.")
+	} else {
+		response.CheapestWeekday = cheapest.Weekday.String()
+		summary.WriteString(fmt.Sprintf("Cheapest weekday to depart: %s (average %s %s).",
+			response.CheapestWeekday, s.formatPrice(cheapest.AveragePrice), response.Currency))
+		for _, w := range response.Weekdays {
+			if w.Samples == 0 {
+				continue
+			}
+			summary.WriteString(fmt.Sprintf("\n%s: average %s, min %s on %s (%d dates)",
+				w.Weekday, s.formatPrice(w.AveragePrice), s.formatPrice(w.MinPrice), w.MinDate, w.Samples))
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
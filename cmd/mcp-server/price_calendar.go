@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Price levels of a calendar date relative to Google's typical price range.
+const (
+	priceLevelLow     = "low"
+	priceLevelTypical = "typical"
+	priceLevelHigh    = "high"
+)
+
+type getPriceCalendarParams struct {
+	RangeStartDate string   `json:"rangeStartDate" jsonschema:"Earliest departure date to show (YYYY-MM-DD)"`
+	RangeEndDate   string   `json:"rangeEndDate" jsonschema:"Last departure date to show (YYYY-MM-DD)"`
+	TripLength     int      `json:"tripLength" jsonschema:"Trip length in days"`
+	SrcCities      []string `json:"srcCities,omitempty" jsonschema:"City names accepted by Google Flights, see airport_lookup"`
+	SrcAirports    []string `json:"srcAirports,omitempty" jsonschema:"IATA codes of departure airports. At least one source city or airport is required"`
+	DstCities      []string `json:"dstCities,omitempty" jsonschema:"Destination city names accepted by Google Flights, see airport_lookup"`
+	DstAirports    []string `json:"dstAirports,omitempty" jsonschema:"IATA codes of destination airports. At least one destination city or airport is required"`
+	Language       string   `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency       string   `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	tripOptionsParams
+}
+
+type calendarDateResponse struct {
+	StartDate  string  `json:"startDate"`
+	ReturnDate string  `json:"returnDate"`
+	Weekday    string  `json:"weekday"`
+	Price      float64 `json:"price"`
+	Level      string  `json:"level,omitempty"` // low, typical or high, empty if Google's price range is unknown
+}
+
+type getPriceCalendarResponse struct {
+	SchemaVersion int                    `json:"schemaVersion"`
+	Route         string                 `json:"route"`  // in the requested language, e.g. "München (MUC) -> New York"
+	Market        string                 `json:"market"` // country of sale the prices apply to, "auto" if derived from the server's IP address
+	Currency      string                 `json:"currency"`
+	TypicalLow    float64                `json:"typicalLow,omitempty"`   // lower bound of Google's typical price range, 0 if unknown
+	TypicalHigh   float64                `json:"typicalHigh,omitempty"`  // upper bound of Google's typical price range, 0 if unknown
+	PriceInsight  *priceInsightResponse  `json:"priceInsight,omitempty"` // Google's assessment of the current prices of the route
+	Dates         []calendarDateResponse `json:"dates"`                  // by departure date
+	CheapestDate  string                 `json:"cheapestDate,omitempty"`
+}
+
+func (s *server) getPriceCalendar(ctx context.Context, _ *mcp.CallToolRequest, params getPriceCalendarParams) (*mcp.CallToolResult, getPriceCalendarResponse, error) {
+	startDate, err := parseDate("rangeStartDate", params.RangeStartDate)
+	if err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	endDate, err := parseDate("rangeEndDate", params.RangeEndDate)
+	if err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	if endDate.Before(startDate) {
+		return nil, getPriceCalendarResponse{}, fmt.Errorf("rangeEndDate must be on or after rangeStartDate")
+	}
+	if params.TripLength <= 0 {
+		return nil, getPriceCalendarResponse{}, fmt.Errorf("tripLength must be positive")
+	}
+	origins := append(append([]string{}, params.SrcCities...), params.SrcAirports...)
+	destinations := append(append([]string{}, params.DstCities...), params.DstAirports...)
+	if len(origins) == 0 {
+		return nil, getPriceCalendarResponse{}, fmt.Errorf("at least one source city or airport is required")
+	}
+	if len(destinations) == 0 {
+		return nil, getPriceCalendarResponse{}, fmt.Errorf("at least one destination city or airport is required")
+	}
+	if err := checkAirportCodes(params.SrcAirports, params.DstAirports); err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	if err := s.routePolicy.Check(origins, destinations); err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	options, err := parseSearchOptions(searchOptionsParams{tripOptionsParams: params.tripOptionsParams}, lang, curr)
+	if err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+
+	offers, err := s.session.GetPriceGraph(ctx, flights.PriceGraphArgs{
+		RangeStartDate: startDate,
+		RangeEndDate:   endDate,
+		TripLength:     params.TripLength,
+		SrcCities:      params.SrcCities,
+		SrcAirports:    params.SrcAirports,
+		DstCities:      params.DstCities,
+		DstAirports:    params.DstAirports,
+		Options:        options,
+	})
+	if err != nil {
+		return nil, getPriceCalendarResponse{}, err
+	}
+	offers = pricedOffers(offers)
+	sort.Slice(offers, func(i, j int) bool { return offers[i].StartDate.Before(offers[j].StartDate) })
+
+	response := getPriceCalendarResponse{
+		SchemaVersion: s.schemaVersion,
+		Route:         routeName(params.SrcCities, params.SrcAirports, params.DstCities, params.DstAirports, lang),
+		Market:        s.marketName(),
+		Currency:      curr.String(),
+		Dates:         make([]calendarDateResponse, 0, len(offers)),
+	}
+	var cheapest flights.Offer
+	for _, o := range offers {
+		if cheapest.Price == 0 || o.Price < cheapest.Price {
+			cheapest = o
+		}
+	}
+
+	// The price graph carries no price range. A single search of the cheapest date
+	// returns Google's typical range of the route, which applies to the whole window.
+	if cheapest.Price > 0 {
+		_, priceRange, err := s.session.GetOffers(ctx, flights.Args{
+			Date:        cheapest.StartDate,
+			ReturnDate:  cheapest.ReturnDate,
+			SrcCities:   params.SrcCities,
+			SrcAirports: params.SrcAirports,
+			DstCities:   params.DstCities,
+			DstAirports: params.DstAirports,
+			Options:     options,
+		})
+		switch {
+		case err != nil:
+			// The calendar is worth more than its price levels.
+			log.Printf("get_price_calendar: price range: %v", err)
+		case priceRange != nil:
+			response.TypicalLow, response.TypicalHigh = s.roundPrice(priceRange.Low), s.roundPrice(priceRange.High)
+			response.PriceInsight = s.newPriceInsightResponse(priceRange.Insight)
+		}
+		response.CheapestDate = cheapest.StartDate.Format(time.DateOnly)
+	}
+
+	for _, o := range offers {
+		response.Dates = append(response.Dates, calendarDateResponse{
+			StartDate:  o.StartDate.Format(time.DateOnly),
+			ReturnDate: o.ReturnDate.Format(time.DateOnly),
+			Weekday:    o.StartDate.Weekday().String(),
+			Price:      s.roundPrice(o.Price),
+			Level:      priceLevel(o.Price, response.TypicalLow, response.TypicalHigh),
+		})
+	}
+
+	var summary strings.Builder
+	if len(response.Dates) == 0 {
+		summary.WriteString("The price graph returned no prices for this window.")
+	} else {
+		summary.WriteString(fmt.Sprintf("%d departure date(s), cheapest %s %s on %s.",
+			len(response.Dates), s.formatPrice(cheapest.Price), response.Currency, response.CheapestDate))
+		if response.TypicalHigh > 0 {
+			summary.WriteString(fmt.Sprintf(" Typical prices are %s-%s.", s.formatPrice(response.TypicalLow), s.formatPrice(response.TypicalHigh)))
+		}
+		if response.PriceInsight != nil {
+			summary.WriteString(" " + response.PriceInsight.GeneratedText)
+		}
+		for _, d := range response.Dates {
+			summary.WriteString(fmt.Sprintf("\n%s (%s): %s", d.StartDate, d.Weekday[:3], s.formatPrice(d.Price)))
+			if d.Level != "" {
+				summary.WriteString(" " + d.Level)
+			}
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+// pricedOffers drops the dates of the price graph without a price.
+func pricedOffers(offers []flights.Offer) []flights.Offer {
+	priced := make([]flights.Offer, 0, len(offers))
+	for _, o := range offers {
+		if o.Price > 0 {
+			priced = append(priced, o)
+		}
+	}
+	return priced
+}
+
+// priceLevel classifies price against Google's typical price range, the way Google
+// Flights labels prices. It returns "" if the range is unknown.
+func priceLevel(price, typicalLow, typicalHigh float64) string {
+	switch {
+	case typicalHigh == 0:
+		return ""
+	case price < typicalLow:
+		return priceLevelLow
+	case price > typicalHigh:
+		return priceLevelHigh
+	}
+	return priceLevelTypical
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestGetPriceCalendar(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	searches := 0
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			var graph []flights.Offer
+			for i, price := range []float64{500, 150, 0, 300} {
+				date := start.AddDate(0, 0, 3-i)
+				graph = append(graph, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, args.TripLength), Price: price})
+			}
+			return graph, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			searches++
+			if !args.Date.Equal(start.AddDate(0, 0, 2)) {
+				t.Errorf("expected a search of the cheapest date, got %v", args.Date)
+			}
+			return nil, &flights.PriceRange{Low: 200, High: 400}, nil
+		},
+	})
+	params := getPriceCalendarParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 3).Format(time.DateOnly),
+		TripLength:     7,
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+	}
+
+	_, response, err := s.getPriceCalendar(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searches != 1 {
+		t.Fatalf("expected a single search for the price range, got %d", searches)
+	}
+	var levels []string
+	for _, d := range response.Dates {
+		levels = append(levels, d.StartDate+" "+d.Level)
+	}
+	want := []string{
+		start.Format(time.DateOnly) + " typical",
+		start.AddDate(0, 0, 2).Format(time.DateOnly) + " low",
+		start.AddDate(0, 0, 3).Format(time.DateOnly) + " high",
+	}
+	if len(levels) != len(want) {
+		t.Fatalf("expected %v, got %v", want, levels)
+	}
+	for i := range want {
+		if levels[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, levels)
+		}
+	}
+	if response.CheapestDate != start.AddDate(0, 0, 2).Format(time.DateOnly) || response.TypicalLow != 200 || response.TypicalHigh != 400 {
+		t.Fatalf("wrong response: %+v", response)
+	}
+}
+
+func TestGetPriceCalendarWithoutPriceRange(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: 100}}, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			return nil, nil, errors.New("rate limited")
+		},
+	})
+	_, response, err := s.getPriceCalendar(context.Background(), nil, getPriceCalendarParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.Format(time.DateOnly),
+		TripLength:     7,
+		SrcAirports:    []string{"SFO"},
+		DstAirports:    []string{"JFK"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Dates) != 1 || response.Dates[0].Level != "" || response.TypicalHigh != 0 {
+		t.Fatalf("expected the calendar without price levels, got %+v", response)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// priceInsightResponse is Google's assessment of the current prices of a route, the
+// "Prices are currently low" line of the price history in Google Flights. Google's
+// response only has the level and the prices of that line, not its wording, so
+// GeneratedText is written by the server from them.
+type priceInsightResponse struct {
+	Level         string  `json:"level"`                // low, typical or high
+	GeneratedText string  `json:"generatedText"`        // e.g. "Google rates the prices low, 16% below the usual price it reports.", not Google's wording
+	UsualPrice    float64 `json:"usualPrice,omitempty"` // the price Google considers usual for the route
+	Percent       int     `json:"percent,omitempty"`    // how much the cheapest price is below (negative: above) the usual price
+}
+
+// newPriceInsightResponse converts the insight of a search, nil if Google didn't
+// report one.
+func (s *server) newPriceInsightResponse(insight flights.PriceInsight) *priceInsightResponse {
+	if insight.Level == flights.PriceLevelUnknown {
+		return nil
+	}
+	response := &priceInsightResponse{
+		Level:      insight.Level.String(),
+		UsualPrice: s.roundPrice(insight.Usual),
+	}
+	if insight.Usual > 0 && insight.Cheapest > 0 {
+		response.Percent = int(math.Round((insight.Usual - insight.Cheapest) / insight.Usual * 100))
+	}
+	response.GeneratedText = fmt.Sprintf("Google rates the prices %s", response.Level)
+	switch {
+	case insight.Level == flights.PriceLevelLow && response.Percent > 0:
+		response.GeneratedText += fmt.Sprintf(", %d%% below the usual price it reports", response.Percent)
+	case insight.Level == flights.PriceLevelHigh && response.Percent < 0:
+		response.GeneratedText += fmt.Sprintf(", %d%% above the usual price it reports", -response.Percent)
+	}
+	response.GeneratedText += "."
+	return response
+}
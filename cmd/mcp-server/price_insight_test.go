@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestNewPriceInsightResponse(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	if response := s.newPriceInsightResponse(flights.PriceInsight{}); response != nil {
+		t.Fatalf("expected no insight without a level, got %+v", response)
+	}
+
+	for _, tc := range []struct {
+		insight flights.PriceInsight
+		text    string
+		percent int
+	}{
+		{flights.PriceInsight{Level: flights.PriceLevelLow, Cheapest: 800, Usual: 1000}, "Google rates the prices low, 20% below the usual price it reports.", 20},
+		{flights.PriceInsight{Level: flights.PriceLevelTypical, Cheapest: 1315, Usual: 1562}, "Google rates the prices typical.", 16},
+		{flights.PriceInsight{Level: flights.PriceLevelHigh, Cheapest: 1300, Usual: 1000}, "Google rates the prices high, 30% above the usual price it reports.", -30},
+		{flights.PriceInsight{Level: flights.PriceLevelHigh}, "Google rates the prices high.", 0},
+	} {
+		response := s.newPriceInsightResponse(tc.insight)
+		if response == nil || response.GeneratedText != tc.text || response.Percent != tc.percent || response.Level != tc.insight.Level.String() {
+			t.Errorf("%+v: got %+v", tc.insight, response)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// progressInterval is the minimum time between two progress notifications of a tool call.
+var progressInterval = 2 * time.Second
+
+// notifyProgress sends progress notifications about a search to the client while it
+// runs, if the client asked for them with a progress token. Notifications are only
+// sent when more dates are done. The returned function stops the notifications.
+func notifyProgress(ctx context.Context, req *mcp.CallToolRequest, progress *cheapoffers.Progress) func() {
+	if req == nil || req.Session == nil || req.Params == nil || req.Params.GetProgressToken() == nil {
+		return func() {}
+	}
+	token := req.Params.GetProgressToken()
+
+	ctx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		lastDone := -1
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			p := progress.Snapshot()
+			if p.Done == lastDone {
+				continue
+			}
+			lastDone = p.Done
+
+			total := p.Pending + p.Active + p.Done
+			message := "Searching Google's price graph"
+			if total > 0 {
+				message = fmt.Sprintf("%d of %d dates scanned, %d offer(s) found so far", p.Done, total, p.Offers)
+			}
+			err := req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+				ProgressToken: token,
+				Progress:      float64(p.Done),
+				Total:         float64(total),
+				Message:       message,
+			})
+			if err != nil {
+				// The client is gone, the search notices through ctx.
+				return
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-stopped
+	}
+}
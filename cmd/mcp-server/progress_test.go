@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestFindCheapestOffersNotifiesProgress(t *testing.T) {
+	interval := progressInterval
+	progressInterval = time.Millisecond
+	t.Cleanup(func() { progressInterval = interval })
+
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 30)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			var graph []flights.Offer
+			for i := range 4 {
+				date := start.AddDate(0, 0, i)
+				graph = append(graph, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: 100})
+			}
+			return graph, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			time.Sleep(20 * time.Millisecond)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100}}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	})
+	s.maxConcurrency = 1
+
+	var (
+		mu            sync.Mutex
+		notifications []*mcp.ProgressNotificationParams
+	)
+	clientSession := connectClient(t, s, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(ctx context.Context, req *mcp.ProgressNotificationClientRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			notifications = append(notifications, req.Params)
+		},
+	})
+
+	// SetProgressToken only works on params that already have metadata.
+	params := &mcp.CallToolParams{Meta: mcp.Meta{}, Name: "find_cheapest_offers", Arguments: findCheapestOffersArgs()}
+	params.SetProgressToken("search-1")
+	if _, err := clientSession.CallTool(context.Background(), params); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(notifications) == 0 {
+		t.Fatal("expected progress notifications")
+	}
+	last := -1.0
+	for _, n := range notifications {
+		if n.ProgressToken != "search-1" {
+			t.Fatalf("wrong progress token: %v", n.ProgressToken)
+		}
+		if n.Progress <= last {
+			t.Fatalf("progress didn't increase: %v after %v", n.Progress, last)
+		}
+		last = n.Progress
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type rebuildLinkParams struct {
+	OfferID string `json:"offerId" jsonschema:"ID of an offer returned by a previous search"`
+}
+
+type rebuildLinkResponse struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	OfferID       string `json:"offerId"`
+	ShareableLink string `json:"shareableLink"`
+}
+
+// linkWarning is the linkWarning of an offer whose shareable link couldn't be
+// generated.
+func linkWarning(linkErr string) string {
+	return fmt.Sprintf("the shareable link couldn't be generated (%s), get it later with rebuild_link", linkErr)
+}
+
+// offersWithoutLink returns the number of offers returned with a linkWarning.
+func offersWithoutLink(offers []offerResponse) int {
+	n := 0
+	for _, o := range offers {
+		if o.LinkWarning != "" {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *server) rebuildLink(ctx context.Context, _ *mcp.CallToolRequest, params rebuildLinkParams) (*mcp.CallToolResult, rebuildLinkResponse, error) {
+	registered, ok, err := s.store.Offer(ctx, params.OfferID)
+	if err != nil {
+		return nil, rebuildLinkResponse{}, fmt.Errorf("load offer: %w", err)
+	}
+	if !ok {
+		return nil, rebuildLinkResponse{}, fmt.Errorf("unknown offerId %q, it may have expired; search again", params.OfferID)
+	}
+	link, err := s.session.SerializeURL(ctx, registered.Args)
+	if err != nil {
+		return nil, rebuildLinkResponse{}, fmt.Errorf("generate link: %w", err)
+	}
+
+	response := rebuildLinkResponse{
+		SchemaVersion: s.schemaVersion,
+		OfferID:       params.OfferID,
+		ShareableLink: link,
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: link},
+		},
+	}
+	return result, response, nil
+}
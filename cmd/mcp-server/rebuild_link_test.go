@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestRebuildLink(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 30)
+	end := start.AddDate(0, 0, 7)
+	session := &fakeSession{
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: start, ReturnDate: end, Price: 300},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+				Flight:         []flights.Flight{{FlightNumber: "UA 1", DepAirportCode: "SFO", ArrAirportCode: "JFK", DepTime: start, ArrTime: start.Add(5 * time.Hour)}},
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 400, High: 800}, nil
+		},
+		serializeErr: errors.New("lookup failed"),
+	}
+	s := newTestServer(t, session)
+
+	// The offer is returned without a link rather than failing the search.
+	result, response, err := s.searchFlights(context.Background(), nil, searchFlightsParams{
+		StartDate:   start.Format(time.DateOnly),
+		ReturnDate:  end.Format(time.DateOnly),
+		SrcAirports: []string{"SFO"},
+		DstAirports: []string{"JFK"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Offers) != 1 || response.Offers[0].ShareableLink != "" || !strings.Contains(response.Offers[0].LinkWarning, "lookup failed") {
+		t.Fatalf("expected an offer with a link warning, got %+v", response.Offers)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "no link yet") {
+		t.Fatalf("the summary doesn't mention the missing link: %q", text)
+	}
+
+	id := response.Offers[0].ID
+	if _, _, err := s.rebuildLink(context.Background(), nil, rebuildLinkParams{OfferID: id}); err == nil {
+		t.Fatal("expected an error while the link can't be generated")
+	}
+	session.serializeErr = nil
+	_, rebuilt, err := s.rebuildLink(context.Background(), nil, rebuildLinkParams{OfferID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rebuilt.OfferID != id || rebuilt.ShareableLink == "" {
+		t.Fatalf("expected the link of the offer, got %+v", rebuilt)
+	}
+
+	if _, _, err := s.rebuildLink(context.Background(), nil, rebuildLinkParams{OfferID: "unknown"}); err == nil {
+		t.Fatal("expected an error for an unknown offer")
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type recheckOfferParams struct {
+	OfferID       string  `json:"offerId,omitempty" jsonschema:"ID of an offer returned by a previous search"`
+	ShareableLink string  `json:"shareableLink,omitempty" jsonschema:"Shareable Google Flights link returned with the offer. One of offerId, shareableLink or startDate, returnDate, srcAirport and dstAirport is required"`
+	StartDate     string  `json:"startDate,omitempty" jsonschema:"Departure date of the offer (YYYY-MM-DD)"`
+	ReturnDate    string  `json:"returnDate,omitempty" jsonschema:"Return date of the offer (YYYY-MM-DD)"`
+	SrcAirport    string  `json:"srcAirport,omitempty" jsonschema:"IATA code of the departure airport"`
+	DstAirport    string  `json:"dstAirport,omitempty" jsonschema:"IATA code of the destination airport"`
+	Language      string  `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency      string  `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	Adults        int     `json:"adults,omitempty" jsonschema:"Optional number of adult travelers, defaults to 1"`
+	ExpectedPrice float64 `json:"expectedPrice,omitempty" jsonschema:"Optional previously returned price, used to report the price change. Defaults to the price of offerId"`
+}
+
+type recheckOfferResponse struct {
+	SchemaVersion int     `json:"schemaVersion"`
+	OfferID       string  `json:"offerId,omitempty"`
+	Market        string  `json:"market"` // country of sale the prices apply to, "auto" if derived from the server's IP address
+	Available     bool    `json:"available"`
+	StartDate     string  `json:"startDate"`
+	ReturnDate    string  `json:"returnDate"`
+	SrcAirport    string  `json:"srcAirport"`
+	DstAirport    string  `json:"dstAirport"`
+	Price         float64 `json:"price,omitempty"`
+	Currency      string  `json:"currency"`
+	PriceChange   float64 `json:"priceChange,omitempty"` // current price minus expectedPrice
+	LowPrice      float64 `json:"lowPrice,omitempty"`
+	HighPrice     float64 `json:"highPrice,omitempty"`
+	ShareableLink string  `json:"shareableLink"`
+
+	PriceInsight *priceInsightResponse `json:"priceInsight,omitempty"` // Google's assessment of the current prices of the route
+
+	Source    string `json:"source"` // always live
+	CheckedAt string `json:"checkedAt"`
+}
+
+func (s *server) recheckOffer(ctx context.Context, _ *mcp.CallToolRequest, params recheckOfferParams) (*mcp.CallToolResult, recheckOfferResponse, error) {
+	args, registeredPrice, err := s.recheckArgs(ctx, params)
+	if err != nil {
+		return nil, recheckOfferResponse{}, err
+	}
+	if params.ExpectedPrice == 0 {
+		params.ExpectedPrice = registeredPrice
+	}
+	if err := s.routePolicy.Check(args.SrcAirports, args.DstAirports); err != nil {
+		return nil, recheckOfferResponse{}, err
+	}
+
+	offers, priceRange, err := s.session.GetOffers(ctx, args)
+	if err != nil {
+		return nil, recheckOfferResponse{}, err
+	}
+
+	link, err := s.session.SerializeURL(ctx, args)
+	if err != nil {
+		return nil, recheckOfferResponse{}, err
+	}
+
+	response := recheckOfferResponse{
+		SchemaVersion: s.schemaVersion,
+		OfferID:       params.OfferID,
+		Market:        s.marketName(),
+		StartDate:     args.Date.Format(time.DateOnly),
+		ReturnDate:    args.ReturnDate.Format(time.DateOnly),
+		SrcAirport:    args.SrcAirports[0],
+		DstAirport:    args.DstAirports[0],
+		Currency:      args.Currency.String(),
+		ShareableLink: link,
+		Source:        sourceLive,
+		CheckedAt:     time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, o := range offers {
+		if o.Price == 0 {
+			continue
+		}
+		if !response.Available || o.Price < response.Price {
+			response.Available = true
+			response.Price = o.Price
+		}
+	}
+	response.Price = s.roundPrice(response.Price)
+	if priceRange != nil {
+		response.LowPrice = s.roundPrice(priceRange.Low)
+		response.HighPrice = s.roundPrice(priceRange.High)
+		response.PriceInsight = s.newPriceInsightResponse(priceRange.Insight)
+	}
+	if response.Available && params.ExpectedPrice > 0 {
+		response.PriceChange = response.Price - s.roundPrice(params.ExpectedPrice)
+	}
+
+	var summary strings.Builder
+	if !response.Available {
+		summary.WriteString(fmt.Sprintf("No bookable offer found anymore for %s -> %s on %s.",
+			response.SrcAirport, response.DstAirport, response.StartDate))
+	} else {
+		summary.WriteString(fmt.Sprintf("%s -> %s on %s is available for %s %s.",
+			response.SrcAirport, response.DstAirport, response.StartDate, s.formatPrice(response.Price), response.Currency))
+		switch {
+		case response.PriceChange > 0:
+			summary.WriteString(fmt.Sprintf(" The price went up by %s.", s.formatPrice(response.PriceChange)))
+		case response.PriceChange < 0:
+			summary.WriteString(fmt.Sprintf(" The price went down by %s.", s.formatPrice(-response.PriceChange)))
+		case params.ExpectedPrice > 0:
+			summary.WriteString(" The price is unchanged.")
+		}
+		if response.PriceInsight != nil {
+			summary.WriteString(" " + response.PriceInsight.GeneratedText)
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+// recheckArgs builds the GetOffers arguments from the offer ID, the shareable link or
+// the offer's identifying fields, in this order. For an offer ID, it also returns the
+// price the offer was reported with.
+func (s *server) recheckArgs(ctx context.Context, params recheckOfferParams) (flights.Args, float64, error) {
+	if params.OfferID != "" {
+		registered, ok, err := s.store.Offer(ctx, params.OfferID)
+		if err != nil {
+			return flights.Args{}, 0, fmt.Errorf("load offer: %w", err)
+		}
+		if !ok {
+			return flights.Args{}, 0, fmt.Errorf("unknown offerId %q, it may have expired; pass shareableLink instead", params.OfferID)
+		}
+		return registered.Args, registered.Price, nil
+	}
+	if params.ShareableLink != "" {
+		args, err := flights.ParseURL(params.ShareableLink)
+		if err != nil {
+			return flights.Args{}, 0, fmt.Errorf("parse shareableLink: %w", err)
+		}
+		if len(args.SrcCities) > 0 || len(args.DstCities) > 0 || len(args.SrcAirports) != 1 || len(args.DstAirports) != 1 {
+			return flights.Args{}, 0, fmt.Errorf("shareableLink must point to a search between exactly one source and one destination airport")
+		}
+		return args, 0, nil
+	}
+
+	if params.StartDate == "" || params.ReturnDate == "" || params.SrcAirport == "" || params.DstAirport == "" {
+		return flights.Args{}, 0, fmt.Errorf("one of offerId, shareableLink or startDate, returnDate, srcAirport and dstAirport is required")
+	}
+	startDate, err := parseDate("startDate", params.StartDate)
+	if err != nil {
+		return flights.Args{}, 0, err
+	}
+	returnDate, err := parseDate("returnDate", params.ReturnDate)
+	if err != nil {
+		return flights.Args{}, 0, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return flights.Args{}, 0, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return flights.Args{}, 0, err
+	}
+	adults, err := parseAdults(params.Adults)
+	if err != nil {
+		return flights.Args{}, 0, err
+	}
+
+	return flights.Args{
+		Date:        startDate,
+		ReturnDate:  returnDate,
+		SrcAirports: []string{strings.ToUpper(params.SrcAirport)},
+		DstAirports: []string{strings.ToUpper(params.DstAirport)},
+		Options: flights.Options{
+			Travelers: flights.Travelers{Adults: adults},
+			Currency:  curr,
+			Stops:     flights.AnyStops,
+			Class:     flights.Economy,
+			TripType:  flights.RoundTrip,
+			Lang:      lang,
+		},
+	}, 0, nil
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxTripLengthSweep is the number of trip lengths recommend_trip_length compares
+// at most, each costs a price graph request.
+const maxTripLengthSweep = 14
+
+type recommendTripLengthParams struct {
+	Month         string   `json:"month" jsonschema:"Month of the departure (YYYY-MM)"`
+	MinTripLength int      `json:"minTripLength,omitempty" jsonschema:"Optional shortest trip length in days to compare, defaults to 3"`
+	MaxTripLength int      `json:"maxTripLength,omitempty" jsonschema:"Optional longest trip length in days to compare, defaults to 14. At most 14 lengths are compared"`
+	SrcCities     []string `json:"srcCities,omitempty" jsonschema:"City names accepted by Google Flights, see airport_lookup"`
+	SrcAirports   []string `json:"srcAirports,omitempty" jsonschema:"IATA codes of departure airports. At least one source city or airport is required"`
+	DstCities     []string `json:"dstCities,omitempty" jsonschema:"Destination city names accepted by Google Flights, see airport_lookup"`
+	DstAirports   []string `json:"dstAirports,omitempty" jsonschema:"IATA codes of destination airports. At least one destination city or airport is required"`
+	Language      string   `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency      string   `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	tripOptionsParams
+}
+
+type tripLengthPriceResponse struct {
+	TripLength     int     `json:"tripLength"`
+	MinPrice       float64 `json:"minPrice,omitempty"` // cheapest round trip departing in the month, 0 if none was priced
+	MinDate        string  `json:"minDate,omitempty"`
+	PricePerDay    float64 `json:"pricePerDay,omitempty"`
+	MarginalPerDay float64 `json:"marginalPerDay,omitempty"` // price of every day added to the previous priced length, may be negative
+}
+
+type recommendTripLengthResponse struct {
+	SchemaVersion int                       `json:"schemaVersion"`
+	Route         string                    `json:"route"`  // in the requested language, e.g. "München (MUC) -> New York"
+	Market        string                    `json:"market"` // country of sale the prices apply to, "auto" if derived from the server's IP address
+	Currency      string                    `json:"currency"`
+	TripLengths   []tripLengthPriceResponse `json:"tripLengths"`
+	Recommended   int                       `json:"recommended,omitempty"` // trip length with the lowest price per day, 0 if none was priced
+}
+
+func (s *server) recommendTripLength(ctx context.Context, _ *mcp.CallToolRequest, params recommendTripLengthParams) (*mcp.CallToolResult, recommendTripLengthResponse, error) {
+	month, err := time.Parse("2006-01", params.Month)
+	if err != nil {
+		return nil, recommendTripLengthResponse{}, fmt.Errorf("parse month: %w", err)
+	}
+	// The price graph only covers future departures.
+	startDate := month
+	if tomorrow := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1); startDate.Before(tomorrow) {
+		startDate = tomorrow
+	}
+	endDate := month.AddDate(0, 1, -1)
+	if endDate.Before(startDate) {
+		return nil, recommendTripLengthResponse{}, fmt.Errorf("month %s is over", params.Month)
+	}
+
+	if params.MinTripLength == 0 {
+		params.MinTripLength = 3
+	}
+	if params.MaxTripLength == 0 {
+		params.MaxTripLength = max(14, params.MinTripLength)
+	}
+	if params.MinTripLength <= 0 || params.MaxTripLength < params.MinTripLength {
+		return nil, recommendTripLengthResponse{}, fmt.Errorf("minTripLength must be positive and at most maxTripLength")
+	}
+	if params.MaxTripLength-params.MinTripLength >= maxTripLengthSweep {
+		return nil, recommendTripLengthResponse{}, fmt.Errorf("at most %d trip lengths can be compared", maxTripLengthSweep)
+	}
+
+	origins := append(append([]string{}, params.SrcCities...), params.SrcAirports...)
+	destinations := append(append([]string{}, params.DstCities...), params.DstAirports...)
+	if len(origins) == 0 {
+		return nil, recommendTripLengthResponse{}, fmt.Errorf("at least one source city or airport is required")
+	}
+	if len(destinations) == 0 {
+		return nil, recommendTripLengthResponse{}, fmt.Errorf("at least one destination city or airport is required")
+	}
+	if err := checkAirportCodes(params.SrcAirports, params.DstAirports); err != nil {
+		return nil, recommendTripLengthResponse{}, err
+	}
+	if err := s.routePolicy.Check(origins, destinations); err != nil {
+		return nil, recommendTripLengthResponse{}, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, recommendTripLengthResponse{}, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return nil, recommendTripLengthResponse{}, err
+	}
+	options, err := parseSearchOptions(searchOptionsParams{tripOptionsParams: params.tripOptionsParams}, lang, curr)
+	if err != nil {
+		return nil, recommendTripLengthResponse{}, err
+	}
+
+	graphs := make(map[int][]flights.Offer, params.MaxTripLength-params.MinTripLength+1)
+	for tripLength := params.MinTripLength; tripLength <= params.MaxTripLength; tripLength++ {
+		offers, err := s.session.GetPriceGraph(ctx, flights.PriceGraphArgs{
+			RangeStartDate: startDate,
+			RangeEndDate:   endDate,
+			TripLength:     tripLength,
+			SrcCities:      params.SrcCities,
+			SrcAirports:    params.SrcAirports,
+			DstCities:      params.DstCities,
+			DstAirports:    params.DstAirports,
+			Options:        options,
+		})
+		if err != nil {
+			return nil, recommendTripLengthResponse{}, fmt.Errorf("price graph of %d days: %w", tripLength, err)
+		}
+		graphs[tripLength] = offers
+	}
+
+	stats := cheapoffers.PricesByTripLength(graphs)
+	response := recommendTripLengthResponse{
+		SchemaVersion: s.schemaVersion,
+		Route:         routeName(params.SrcCities, params.SrcAirports, params.DstCities, params.DstAirports, lang),
+		Market:        s.marketName(),
+		Currency:      curr.String(),
+		TripLengths:   make([]tripLengthPriceResponse, 0, len(stats)),
+	}
+	for _, st := range stats {
+		length := tripLengthPriceResponse{TripLength: st.TripLength}
+		if st.MinPrice > 0 {
+			length.MinPrice = s.roundPrice(st.MinPrice)
+			length.MinDate = st.MinDate.Format(time.DateOnly)
+			length.PricePerDay = s.roundPrice(st.PricePerDay)
+			length.MarginalPerDay = s.roundPrice(st.MarginalPerDay)
+		}
+		response.TripLengths = append(response.TripLengths, length)
+	}
+
+	var summary strings.Builder
+	best := cheapoffers.RecommendTripLength(stats)
+	if best < 0 {
+		summary.WriteString("The price graph returned no prices for this month.")
+	} else {
+		recommended := stats[best]
+		response.Recommended = recommended.TripLength
+		summary.WriteString(fmt.Sprintf("Recommended: %d days for %s %s (%s per day, departing %s).",
+			recommended.TripLength, s.formatPrice(recommended.MinPrice), response.Currency,
+			s.formatPrice(recommended.PricePerDay), recommended.MinDate.Format(time.DateOnly)))
+		for _, l := range response.TripLengths {
+			if l.MinPrice == 0 {
+				continue
+			}
+			summary.WriteString(fmt.Sprintf("\n%d days: %s, %s per day", l.TripLength, s.formatPrice(l.MinPrice), s.formatPrice(l.PricePerDay)))
+			if l.MarginalPerDay != 0 {
+				summary.WriteString(fmt.Sprintf(", %s per added day", s.formatPrice(l.MarginalPerDay)))
+			}
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
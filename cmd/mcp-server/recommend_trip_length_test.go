@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestRecommendTripLength(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, 2, 0)
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	prices := map[int]float64{3: 360, 4: 400, 5: 420, 6: 600}
+
+	var windows []flights.PriceGraphArgs
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			windows = append(windows, args)
+			return []flights.Offer{
+				{StartDate: start.AddDate(0, 0, 2), Price: prices[args.TripLength] + 50},
+				{StartDate: start.AddDate(0, 0, 9), Price: prices[args.TripLength]},
+			}, nil
+		},
+	})
+
+	result, response, err := s.recommendTripLength(context.Background(), nil, recommendTripLengthParams{
+		Month:         start.Format("2006-01"),
+		MinTripLength: 3,
+		MaxTripLength: 6,
+		SrcAirports:   []string{"SFO"},
+		DstCities:     []string{"Lisbon"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 4 || !windows[0].RangeStartDate.Equal(start) || !windows[0].RangeEndDate.Equal(start.AddDate(0, 1, -1)) {
+		t.Fatalf("expected a price graph of the month per trip length, got %+v", windows)
+	}
+	// 5 days cost 84 a day, the 5th day only 20.
+	if response.Recommended != 5 || len(response.TripLengths) != 4 {
+		t.Fatalf("expected 5 days to be recommended, got %+v", response)
+	}
+	five := response.TripLengths[2]
+	if five.MinPrice != 420 || five.PricePerDay != 84 || five.MarginalPerDay != 20 || five.MinDate != start.AddDate(0, 0, 9).Format(time.DateOnly) {
+		t.Fatalf("wrong stats of 5 days: %+v", five)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.HasPrefix(text, "Recommended: 5 days") {
+		t.Fatalf("wrong summary: %q", text)
+	}
+
+	for _, params := range []recommendTripLengthParams{
+		{Month: "2020-01", SrcAirports: []string{"SFO"}, DstCities: []string{"Lisbon"}},
+		{Month: start.Format("2006-01"), MinTripLength: 1, MaxTripLength: 30, SrcAirports: []string{"SFO"}, DstCities: []string{"Lisbon"}},
+		{Month: start.Format("2006-01"), SrcAirports: []string{"SFO"}},
+	} {
+		if _, _, err := s.recommendTripLength(context.Background(), nil, params); err == nil {
+			t.Errorf("expected an error for %+v", params)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"golang.org/x/text/language"
+)
+
+// fixtureSession is the part of [flights.Session] the record-fixtures subcommand
+// searches with.
+type fixtureSession interface {
+	AbbrCity(ctx context.Context, city string, lang language.Tag) (string, error)
+	GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error)
+	GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error)
+}
+
+// fixtureStep is a live search whose responses are stored as the fixture name.
+type fixtureStep struct {
+	name string
+	run  func(ctx context.Context, session fixtureSession) error
+}
+
+// fixtureSteps returns the searches refreshing the fixtures of the flights tests:
+// the lookups of both cities, a price graph and the offers of a round trip a month
+// ahead. The cities are looked up first, so the later searches hit the city cache
+// and their fixtures contain a single response.
+func fixtureSteps(origin, destination string, now time.Time) []fixtureStep {
+	start := now.AddDate(0, 1, 0)
+	cityStep := func(city string) fixtureStep {
+		return fixtureStep{
+			name: "city_" + fixtureName(city),
+			run: func(ctx context.Context, session fixtureSession) error {
+				_, err := session.AbbrCity(ctx, city, language.English)
+				return err
+			},
+		}
+	}
+	return []fixtureStep{
+		cityStep(origin),
+		cityStep(destination),
+		{
+			name: "price_graph",
+			run: func(ctx context.Context, session fixtureSession) error {
+				_, err := session.GetPriceGraph(ctx, flights.PriceGraphArgs{
+					RangeStartDate: start,
+					RangeEndDate:   start.AddDate(0, 0, 30),
+					TripLength:     7,
+					SrcCities:      []string{origin},
+					DstCities:      []string{destination},
+					Options:        flights.OptionsDefault(),
+				})
+				return err
+			},
+		},
+		{
+			name: "flight",
+			run: func(ctx context.Context, session fixtureSession) error {
+				_, _, err := session.GetOffers(ctx, flights.Args{
+					Date:       start,
+					ReturnDate: start.AddDate(0, 0, 7),
+					SrcCities:  []string{origin},
+					DstCities:  []string{destination},
+					Options:    flights.OptionsDefault(),
+				})
+				return err
+			},
+		},
+	}
+}
+
+// fixtureName turns a city name into a file name, e.g. "New York" into new_york.
+func fixtureName(city string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(city)), " ", "_")
+}
+
+// fixtureRecorder collects the responses recorded by a session for the running
+// step.
+type fixtureRecorder struct {
+	mu     sync.Mutex
+	bodies [][]byte
+}
+
+// record is passed to [flights.WithResponseRecorder].
+func (r *fixtureRecorder) record(endpoint string, body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+}
+
+// take returns the responses recorded since the last call.
+func (r *fixtureRecorder) take() [][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bodies := r.bodies
+	r.bodies = nil
+	return bodies
+}
+
+// ipv4Pattern matches IPv4 addresses, Google echoes the client address in some
+// responses.
+var ipv4Pattern = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+
+// sanitizeFixture removes what identifies the operator from a response: the
+// values of the cookies sent with the requests and IP addresses. Only bodies are
+// recorded, so the response headers setting cookies never end up in a fixture.
+func sanitizeFixture(body []byte, cookies []string) []byte {
+	for _, cookie := range cookies {
+		_, value, _ := strings.Cut(cookie, "=")
+		if len(value) >= 8 { // shorter values like YES+ would redact the data
+			body = bytes.ReplaceAll(body, []byte(value), []byte("REDACTED"))
+		}
+	}
+	return ipv4Pattern.ReplaceAll(body, []byte("0.0.0.0"))
+}
+
+// recordFixtures runs the steps with session and writes the sanitized responses of
+// every step to dir: NAME.resp for a single response, NAME_1.resp, NAME_2.resp and
+// so on for several. It returns the paths of the files written.
+func recordFixtures(ctx context.Context, session fixtureSession, recorder *fixtureRecorder, steps []fixtureStep, dir string, cookies []string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create fixtures directory: %w", err)
+	}
+	var paths []string
+	for _, step := range steps {
+		recorder.take()
+		if err := step.run(ctx, session); err != nil {
+			return paths, fmt.Errorf("%s: %w", step.name, err)
+		}
+		bodies := recorder.take()
+		if len(bodies) == 0 {
+			return paths, fmt.Errorf("%s: no response was recorded", step.name)
+		}
+		for i, body := range bodies {
+			name := step.name + ".resp"
+			if len(bodies) > 1 {
+				name = fmt.Sprintf("%s_%d.resp", step.name, i+1)
+			}
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, sanitizeFixture(body, cookies), 0o644); err != nil {
+				return paths, fmt.Errorf("write fixture: %w", err)
+			}
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// runRecordFixtures implements the record-fixtures subcommand. It makes live
+// requests to Google, so it refuses to run without the operator's consent.
+func runRecordFixtures(consent bool, dir, origin, destination string, jar *flights.CookieJar, consentCookies []string, opts []flights.SessionOption) int {
+	if !consent {
+		log.Printf("record-fixtures sends live searches to Google Flights from this machine; pass --record-consent to allow it")
+		return 2
+	}
+	if dir == "" {
+		log.Printf("record-fixtures needs --fixtures-dir")
+		return 2
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	recorder := &fixtureRecorder{}
+	session, err := flights.New(append(opts, flights.WithResponseRecorder(recorder.record))...)
+	if err != nil {
+		log.Printf("create session: %v", err)
+		return 1
+	}
+	// The jar holds the cookies Google set while creating the session as well.
+	cookies := append(jar.Cookies(), consentCookies...)
+	paths, err := recordFixtures(ctx, session, recorder, fixtureSteps(origin, destination, time.Now()), dir, cookies)
+	for _, path := range paths {
+		fmt.Println(path)
+	}
+	if err != nil {
+		log.Printf("record fixtures: %v", err)
+		return 1
+	}
+	return 0
+}
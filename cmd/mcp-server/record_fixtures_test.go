@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"golang.org/x/text/language"
+)
+
+// recordingFixtureSession answers every search with canned bodies passed to the
+// recorder, like a session created with flights.WithResponseRecorder.
+type recordingFixtureSession struct {
+	recorder *fixtureRecorder
+	bodies   map[string][]string // per method
+}
+
+func (s *recordingFixtureSession) respond(method string) {
+	for _, body := range s.bodies[method] {
+		s.recorder.record(method, []byte(body))
+	}
+}
+
+func (s *recordingFixtureSession) AbbrCity(ctx context.Context, city string, lang language.Tag) (string, error) {
+	s.respond("AbbrCity")
+	return "/m/" + city, nil
+}
+
+func (s *recordingFixtureSession) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	s.respond("GetPriceGraph")
+	return nil, nil
+}
+
+func (s *recordingFixtureSession) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	s.respond("GetOffers")
+	return nil, nil, nil
+}
+
+func TestRecordFixtures(t *testing.T) {
+	recorder := &fixtureRecorder{}
+	session := &recordingFixtureSession{recorder: recorder, bodies: map[string][]string{
+		"AbbrCity":      {`)]}'` + "\ncity from 203.0.113.7"},
+		"GetPriceGraph": {"graph", "graph with session secretvalue123"},
+		"GetOffers":     {"offers"},
+	}}
+	dir := filepath.Join(t.TempDir(), "testdata")
+	steps := fixtureSteps("New York", "Athens", time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	paths, err := recordFixtures(context.Background(), session, recorder, steps, dir, []string{"NID=secretvalue123", "CONSENT=YES+"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, path := range paths {
+		names = append(names, filepath.Base(path))
+	}
+	want := []string{"city_new_york.resp", "city_athens.resp", "price_graph_1.resp", "price_graph_2.resp", "flight.resp"}
+	if !slices.Equal(names, want) {
+		t.Fatalf("wrong fixtures %q, expected %q", names, want)
+	}
+
+	for name, content := range map[string]string{
+		"city_athens.resp":   `)]}'` + "\ncity from 0.0.0.0",
+		"price_graph_2.resp": "graph with session REDACTED",
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != content {
+			t.Errorf("%s: got %q, expected %q", name, data, content)
+		}
+	}
+
+	session.bodies["GetOffers"] = nil
+	if _, err := recordFixtures(context.Background(), session, recorder, steps, dir, nil); err == nil {
+		t.Fatal("expected an error for a search without a response")
+	}
+}
+
+func TestRecordFixturesConsent(t *testing.T) {
+	if code := runRecordFixtures(false, t.TempDir(), "Warsaw", "Athens", nil, nil, nil); code != 2 {
+		t.Fatalf("expected exit code 2 without consent, got %d", code)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Price rounding modes, see --price-rounding.
+const (
+	roundingRaw     = "raw"     // prices as Google Flights reports them
+	roundingWhole   = "whole"   // whole currency units, halves away from zero
+	roundingBankers = "bankers" // whole currency units, halves to even
+)
+
+func checkPriceRounding(mode string) error {
+	switch mode {
+	case roundingRaw, roundingWhole, roundingBankers:
+		return nil
+	}
+	return fmt.Errorf("unknown price rounding %q, expected %s, %s or %s", mode, roundingRaw, roundingWhole, roundingBankers)
+}
+
+// roundPrice rounds a price of a structured response according to --price-rounding.
+// Differences between prices are computed from the rounded prices, so that they add up.
+func (s *server) roundPrice(price float64) float64 {
+	switch s.priceRounding {
+	case roundingWhole:
+		return math.Round(price)
+	case roundingBankers:
+		return math.RoundToEven(price)
+	}
+	return price
+}
+
+// formatPrice formats a price of a text summary with the precision of the structured
+// response.
+func (s *server) formatPrice(price float64) string {
+	return strconv.FormatFloat(s.roundPrice(price), 'f', -1, 64)
+}
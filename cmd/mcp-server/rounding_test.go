@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestRoundPrice(t *testing.T) {
+	tests := []struct {
+		rounding string
+		price    float64
+		rounded  float64
+		text     string
+	}{
+		{"", 123.5, 123.5, "123.5"},
+		{roundingRaw, 99.99, 99.99, "99.99"},
+		{roundingWhole, 123.5, 124, "124"},
+		{roundingWhole, 122.5, 123, "123"},
+		{roundingBankers, 123.5, 124, "124"},
+		{roundingBankers, 122.5, 122, "122"},
+		{roundingBankers, 122.51, 123, "123"},
+	}
+	for _, tt := range tests {
+		s := &server{priceRounding: tt.rounding}
+		if rounded := s.roundPrice(tt.price); rounded != tt.rounded {
+			t.Errorf("%s %v: expected %v, got %v", tt.rounding, tt.price, tt.rounded, rounded)
+		}
+		if text := s.formatPrice(tt.price); text != tt.text {
+			t.Errorf("%s %v: expected %q, got %q", tt.rounding, tt.price, tt.text, text)
+		}
+	}
+
+	if err := checkPriceRounding("cents"); err == nil {
+		t.Fatal("expected an error for an unknown rounding")
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+// currentSchemaVersion is the version of the structured tool responses, reported in
+// their schemaVersion field. Adding fields doesn't change the version. Renaming a
+// field or changing its format does: bump the version, and keep producing the old
+// shape when s.schemaVersion is below the new version, so that deployments running
+// with --schema-version set to an older version don't break their clients.
+const currentSchemaVersion = 1
+
+// oldestSchemaVersion is the oldest version the server can still produce.
+const oldestSchemaVersion = 1
+
+func checkSchemaVersion(version int) error {
+	if version < oldestSchemaVersion || version > currentSchemaVersion {
+		return fmt.Errorf("unsupported schema version %d, supported versions are %d to %d", version, oldestSchemaVersion, currentSchemaVersion)
+	}
+	return nil
+}
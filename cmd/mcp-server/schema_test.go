@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestResponsesCarrySchemaVersion(t *testing.T) {
+	clientSession := connect(t, newTestServer(t, &fakeSession{}))
+	result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{Name: "server_info", Arguments: map[string]any{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	structured, ok := result.StructuredContent.(map[string]any)
+	if !ok {
+		t.Fatalf("unexpected structured content: %#v", result.StructuredContent)
+	}
+	if v := structured["schemaVersion"]; v != float64(currentSchemaVersion) {
+		t.Fatalf("wrong schemaVersion: %v", v)
+	}
+}
+
+func TestCheckSchemaVersion(t *testing.T) {
+	if err := checkSchemaVersion(currentSchemaVersion); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []int{oldestSchemaVersion - 1, currentSchemaVersion + 1} {
+		if err := checkSchemaVersion(v); err == nil {
+			t.Fatalf("expected an error for schema version %d", v)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/iata"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// defaultMaxFlightOffers is the number of offers search_flights returns unless
+// maxOffers says otherwise.
+const defaultMaxFlightOffers = 10
+
+type searchFlightsParams struct {
+	StartDate   string   `json:"startDate" jsonschema:"Departure date (YYYY-MM-DD)"`
+	ReturnDate  string   `json:"returnDate,omitempty" jsonschema:"Return date (YYYY-MM-DD), required unless tripType is one-way"`
+	SrcCities   []string `json:"srcCities,omitempty" jsonschema:"City names accepted by Google Flights"`
+	SrcAirports []string `json:"srcAirports,omitempty" jsonschema:"IATA codes of departure airports. At least one source city or airport is required"`
+	DstCities   []string `json:"dstCities,omitempty" jsonschema:"Destination city names accepted by Google Flights"`
+	DstAirports []string `json:"dstAirports,omitempty" jsonschema:"IATA codes of destination airports. At least one destination city or airport is required"`
+	Language    string   `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency    string   `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	searchOptionsParams
+	MaxOffers int `json:"maxOffers,omitempty" jsonschema:"Optional maximum number of offers to return, cheapest first, defaults to 10"`
+}
+
+type flightResponse struct {
+	FlightNumber    string `json:"flightNumber"`
+	AirlineName     string `json:"airlineName"`
+	DepAirport      string `json:"depAirport"`
+	ArrAirport      string `json:"arrAirport"`
+	DepTime         string `json:"depTime"`
+	ArrTime         string `json:"arrTime"`
+	DurationMinutes int    `json:"durationMinutes"`
+	Airplane        string `json:"airplane,omitempty"`
+}
+
+type layoverResponse struct {
+	Airport         string `json:"airport"`
+	DurationMinutes int    `json:"durationMinutes"`
+}
+
+type flightOfferResponse struct {
+	ID                   string            `json:"id"` // pass to recheck_offer
+	StartDate            string            `json:"startDate"`
+	ReturnDate           string            `json:"returnDate,omitempty"` // omitted for one-way trips
+	SrcAirport           string            `json:"srcAirport"`
+	DstAirport           string            `json:"dstAirport"`
+	SrcCity              string            `json:"srcCity,omitempty"` // in the language of the search
+	DstCity              string            `json:"dstCity,omitempty"`
+	Price                float64           `json:"price"`
+	Currency             string            `json:"currency"`
+	Airlines             []string          `json:"airlines"`
+	FlightNumbers        []string          `json:"flightNumbers"`
+	Stops                int               `json:"stops"`
+	TotalDurationMinutes int               `json:"totalDurationMinutes"`
+	Flights              []flightResponse  `json:"flights"` // outbound flights, Google doesn't report the return flights with the offer
+	Layovers             []layoverResponse `json:"layovers"`
+	OvernightLayover     bool              `json:"overnightLayover,omitempty"` // a layover covers the local night
+	SelfTransferRisk     bool              `json:"selfTransferRisk,omitempty"` // a connection changes airports, usually on separate tickets
+	ShareableLink        string            `json:"shareableLink"`
+	LinkWarning          string            `json:"linkWarning,omitempty"` // why shareableLink is empty, see rebuild_link
+	Source               string            `json:"source"`                // always live
+	FetchedAt            string            `json:"fetchedAt"`
+	EstimatedStability   string            `json:"estimatedStability,omitempty"` // see offerResponse
+}
+
+type searchFlightsResponse struct {
+	SchemaVersion int                   `json:"schemaVersion"`
+	Market        string                `json:"market"` // country of sale the prices apply to, "auto" if derived from the server's IP address
+	Offers        []flightOfferResponse `json:"offers"`
+	TotalOffers   int                   `json:"totalOffers"` // offers found before applying maxOffers
+	LowPrice      float64               `json:"lowPrice,omitempty"`
+	HighPrice     float64               `json:"highPrice,omitempty"`
+	PriceInsight  *priceInsightResponse `json:"priceInsight,omitempty"` // Google's assessment of the current prices of the route
+}
+
+func (s *server) searchFlights(ctx context.Context, req *mcp.CallToolRequest, params searchFlightsParams) (*mcp.CallToolResult, searchFlightsResponse, error) {
+	startDate, err := parseDate("startDate", params.StartDate)
+	if err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	if params.MaxOffers < 0 {
+		return nil, searchFlightsResponse{}, fmt.Errorf("maxOffers must not be negative")
+	}
+	if params.MaxOffers == 0 {
+		params.MaxOffers = defaultMaxFlightOffers
+	}
+	origins := append(append([]string{}, params.SrcCities...), params.SrcAirports...)
+	destinations := append(append([]string{}, params.DstCities...), params.DstAirports...)
+	if err := checkAirportCodes(params.SrcAirports, params.DstAirports); err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	if err := s.routePolicy.Check(origins, destinations); err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	options, err := parseSearchOptions(params.searchOptionsParams, lang, curr)
+	if err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	// One-way searches don't have a return date, GetOffers expects the departure date.
+	returnDate := startDate
+	if options.TripType == flights.RoundTrip {
+		if returnDate, err = parseDate("returnDate", params.ReturnDate); err != nil {
+			return nil, searchFlightsResponse{}, err
+		}
+	}
+	args := flights.Args{
+		Date:        startDate,
+		ReturnDate:  returnDate,
+		SrcCities:   params.SrcCities,
+		SrcAirports: params.SrcAirports,
+		DstCities:   params.DstCities,
+		DstAirports: params.DstAirports,
+		Options:     options,
+	}
+	if err := args.ValidateOffersArgs(); err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+
+	offers, priceRange, err := s.session.GetOffers(ctx, args)
+	if err != nil {
+		return nil, searchFlightsResponse{}, err
+	}
+	now := time.Now().UTC()
+	fetchedAt := now.Format(time.RFC3339)
+
+	priced := make([]flights.FullOffer, 0, len(offers))
+	for _, o := range offers {
+		if o.Price > 0 {
+			priced = append(priced, o)
+		}
+	}
+	sort.SliceStable(priced, func(i, j int) bool {
+		return priced[i].Price < priced[j].Price
+	})
+
+	response := searchFlightsResponse{
+		SchemaVersion: s.schemaVersion,
+		Market:        s.marketName(),
+		Offers:        make([]flightOfferResponse, 0, min(len(priced), params.MaxOffers)),
+		TotalOffers:   len(priced),
+	}
+	if priceRange != nil {
+		response.LowPrice = s.roundPrice(priceRange.Low)
+		response.HighPrice = s.roundPrice(priceRange.High)
+		response.PriceInsight = s.newPriceInsightResponse(priceRange.Insight)
+	}
+
+	for _, o := range priced[:min(len(priced), params.MaxOffers)] {
+		offerArgs := flights.Args{
+			Date:        o.StartDate,
+			ReturnDate:  returnDate,
+			SrcAirports: []string{o.SrcAirportCode},
+			DstAirports: []string{o.DstAirportCode},
+			Options:     options,
+		}
+		link, linkErr := s.session.SerializeURL(ctx, offerArgs)
+
+		offer := flightOfferResponse{
+			ID:                   cheapoffers.FullOfferID(o),
+			StartDate:            o.StartDate.Format(time.RFC3339),
+			SrcAirport:           o.SrcAirportCode,
+			DstAirport:           o.DstAirportCode,
+			SrcCity:              iata.CityName(o.SrcAirportCode, lang),
+			DstCity:              iata.CityName(o.DstAirportCode, lang),
+			Price:                s.roundPrice(o.Price),
+			Currency:             curr.String(),
+			Airlines:             []string{},
+			FlightNumbers:        make([]string, 0, len(o.Flight)),
+			Stops:                max(len(o.Flight)-1, 0),
+			TotalDurationMinutes: int(o.FlightDuration.Minutes()),
+			Flights:              make([]flightResponse, 0, len(o.Flight)),
+			Layovers:             []layoverResponse{},
+			OvernightLayover:     cheapoffers.OvernightLayover(o),
+			SelfTransferRisk:     cheapoffers.SelfTransferRisk(o),
+			ShareableLink:        link,
+			Source:               sourceLive,
+			FetchedAt:            fetchedAt,
+		}
+		if linkErr != nil {
+			offer.LinkWarning = linkWarning(linkErr.Error())
+		}
+		if options.TripType == flights.RoundTrip {
+			offer.ReturnDate = o.ReturnDate.Format(time.RFC3339)
+		}
+		for i, f := range o.Flight {
+			offer.FlightNumbers = append(offer.FlightNumbers, f.FlightNumber)
+			if f.AirlineName != "" && !slices.Contains(offer.Airlines, f.AirlineName) {
+				offer.Airlines = append(offer.Airlines, f.AirlineName)
+			}
+			offer.Flights = append(offer.Flights, flightResponse{
+				FlightNumber:    f.FlightNumber,
+				AirlineName:     f.AirlineName,
+				DepAirport:      f.DepAirportCode,
+				ArrAirport:      f.ArrAirportCode,
+				DepTime:         f.DepTime.Format(time.RFC3339),
+				ArrTime:         f.ArrTime.Format(time.RFC3339),
+				DurationMinutes: int(f.Duration.Minutes()),
+				Airplane:        f.Airplane,
+			})
+			if i > 0 {
+				previous := o.Flight[i-1]
+				offer.Layovers = append(offer.Layovers, layoverResponse{
+					Airport:         previous.ArrAirportCode,
+					DurationMinutes: int(f.DepTime.Sub(previous.ArrTime).Minutes()),
+				})
+			}
+		}
+		offer.EstimatedStability, err = s.observeFare(ctx, offer.ID, options, o.Price, now)
+		if err != nil {
+			return nil, searchFlightsResponse{}, err
+		}
+		response.Offers = append(response.Offers, offer)
+
+		err = s.store.SaveOffer(ctx, offer.ID, registeredOffer{Args: offerArgs, Price: o.Price})
+		if err != nil {
+			return nil, searchFlightsResponse{}, fmt.Errorf("save offer: %w", err)
+		}
+	}
+
+	var summary strings.Builder
+	if options.TripType == flights.RoundTrip {
+		summary.WriteString(fmt.Sprintf("Found %d offer(s) for %s to %s", response.TotalOffers, params.StartDate, params.ReturnDate))
+	} else {
+		summary.WriteString(fmt.Sprintf("Found %d one-way offer(s) for %s", response.TotalOffers, params.StartDate))
+	}
+	if len(response.Offers) < response.TotalOffers {
+		summary.WriteString(fmt.Sprintf(", showing the %d cheapest", len(response.Offers)))
+	}
+	summary.WriteString(".")
+	if response.PriceInsight != nil {
+		summary.WriteString(" " + response.PriceInsight.GeneratedText)
+	}
+	if textOnlyClient(req) && len(response.Offers) > 0 {
+		summary.WriteString("\n\n" + s.flightOffersTable(response.Offers))
+	} else {
+		for _, o := range response.Offers {
+			summary.WriteString(fmt.Sprintf("\n%s -> %s: %s %s, %s, %d stop(s), %dh%02dm",
+				o.SrcAirport, o.DstAirport, s.formatPrice(o.Price), o.Currency, strings.Join(o.FlightNumbers, " / "),
+				o.Stops, o.TotalDurationMinutes/60, o.TotalDurationMinutes%60))
+			if o.OvernightLayover {
+				summary.WriteString(", overnight layover")
+			}
+			if o.SelfTransferRisk {
+				summary.WriteString(", airport change")
+			}
+			if o.LinkWarning != "" {
+				summary.WriteString(", no link yet")
+			}
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
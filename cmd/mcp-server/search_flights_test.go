@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestSearchFlights(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 30)
+	end := start.AddDate(0, 0, 7)
+	dep := start.Add(7 * time.Hour)
+	connecting := flights.FullOffer{
+		Offer:          flights.Offer{StartDate: start, ReturnDate: end, Price: 300},
+		SrcAirportCode: "SFO",
+		DstAirportCode: "BER",
+		FlightDuration: 14 * time.Hour,
+		Flight: []flights.Flight{
+			{FlightNumber: "UA 58", AirlineName: "United", DepAirportCode: "SFO", ArrAirportCode: "FRA", DepTime: dep, ArrTime: dep.Add(11 * time.Hour), Duration: 11 * time.Hour},
+			{FlightNumber: "LH 190", AirlineName: "Lufthansa", DepAirportCode: "FRA", ArrAirportCode: "BER", DepTime: dep.Add(13 * time.Hour), ArrTime: dep.Add(14 * time.Hour), Duration: time.Hour},
+		},
+	}
+	expensive := connecting
+	expensive.Price = 900
+	unpriced := connecting
+	unpriced.Price = 0
+
+	var searched flights.Args
+	s := newTestServer(t, &fakeSession{
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			searched = args
+			insight := flights.PriceInsight{Level: flights.PriceLevelLow, Cheapest: 300, Usual: 400}
+			return []flights.FullOffer{expensive, unpriced, connecting}, &flights.PriceRange{Low: 400, High: 800, Insight: insight}, nil
+		},
+	})
+
+	result, response, err := s.searchFlights(context.Background(), nil, searchFlightsParams{
+		StartDate:   start.Format(time.DateOnly),
+		ReturnDate:  end.Format(time.DateOnly),
+		SrcAirports: []string{"SFO"},
+		DstCities:   []string{"Berlin"},
+		MaxOffers:   1,
+		Language:    "it",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !searched.Date.Equal(start) || !searched.ReturnDate.Equal(end) || searched.SrcAirports[0] != "SFO" || searched.DstCities[0] != "Berlin" {
+		t.Fatalf("wrong search arguments: %+v", searched)
+	}
+	if response.TotalOffers != 2 || len(response.Offers) != 1 {
+		t.Fatalf("expected the cheapest of 2 priced offers, got %d of %d", len(response.Offers), response.TotalOffers)
+	}
+	const insight = "Google rates the prices low, 25% below the usual price it reports."
+	if response.PriceInsight == nil || response.PriceInsight.GeneratedText != insight {
+		t.Fatalf("wrong price insight: %+v", response.PriceInsight)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, insight) {
+		t.Fatalf("expected the summary to quote the price insight, got %q", text)
+	}
+
+	offer := response.Offers[0]
+	if offer.Price != 300 || offer.Stops != 1 || offer.TotalDurationMinutes != 14*60 {
+		t.Fatalf("wrong offer: %+v", offer)
+	}
+	if !reflect.DeepEqual(offer.Airlines, []string{"United", "Lufthansa"}) || !reflect.DeepEqual(offer.FlightNumbers, []string{"UA 58", "LH 190"}) {
+		t.Fatalf("wrong airlines or flight numbers: %+v", offer)
+	}
+	if !reflect.DeepEqual(offer.Layovers, []layoverResponse{{Airport: "FRA", DurationMinutes: 120}}) {
+		t.Fatalf("wrong layovers: %+v", offer.Layovers)
+	}
+	if offer.SrcCity != "San Francisco" || offer.DstCity != "Berlino" {
+		t.Fatalf("expected the city names in Italian, got %q and %q", offer.SrcCity, offer.DstCity)
+	}
+
+	// The offer can be re-checked by its ID.
+	registered, ok, err := s.store.Offer(context.Background(), offer.ID)
+	if err != nil || !ok {
+		t.Fatalf("offer not saved: %v", err)
+	}
+	if registered.Price != 300 || registered.Args.SrcAirports[0] != "SFO" || registered.Args.DstAirports[0] != "BER" {
+		t.Fatalf("wrong saved offer: %+v", registered)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type deleteSearchParams struct {
+	SearchID string `json:"searchId" jsonschema:"searchId of the search, as returned by find_cheapest_offers"`
+}
+
+type restoreSearchParams struct {
+	SearchID string `json:"searchId" jsonschema:"searchId of the deleted search"`
+}
+
+type deleteSearchResponse struct {
+	SchemaVersion   int    `json:"schemaVersion"`
+	SearchID        string `json:"searchId"`
+	RestorableUntil string `json:"restorableUntil"` // restore_search restores the search until then, unless newer searches evict it
+}
+
+type restoreSearchResponse struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	SearchID      string `json:"searchId"`
+	CreatedAt     string `json:"createdAt"`
+	Offers        int    `json:"offers"` // number of offers of the search
+}
+
+func (s *server) deleteSearch(ctx context.Context, _ *mcp.CallToolRequest, params deleteSearchParams) (*mcp.CallToolResult, deleteSearchResponse, error) {
+	ok, err := s.store.DeleteSearch(ctx, params.SearchID)
+	if err != nil {
+		return nil, deleteSearchResponse{}, fmt.Errorf("delete search: %w", err)
+	}
+	if !ok {
+		return nil, deleteSearchResponse{}, fmt.Errorf("unknown searchId %q, it may have expired", params.SearchID)
+	}
+
+	response := deleteSearchResponse{
+		SchemaVersion:   s.schemaVersion,
+		SearchID:        params.SearchID,
+		RestorableUntil: time.Now().UTC().Add(deletedRetention).Format(time.RFC3339),
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Deleted search %s. restore_search restores it until %s, unless newer searches evict it first.",
+				params.SearchID, response.RestorableUntil)},
+		},
+	}
+	return result, response, nil
+}
+
+func (s *server) restoreSearch(ctx context.Context, _ *mcp.CallToolRequest, params restoreSearchParams) (*mcp.CallToolResult, restoreSearchResponse, error) {
+	search, ok, err := s.store.RestoreSearch(ctx, params.SearchID)
+	if err != nil {
+		return nil, restoreSearchResponse{}, fmt.Errorf("restore search: %w", err)
+	}
+	if !ok {
+		return nil, restoreSearchResponse{}, fmt.Errorf("no deleted search %q, it may have been deleted more than %d days ago or evicted",
+			params.SearchID, int(deletedRetention.Hours()/24))
+	}
+
+	response := restoreSearchResponse{
+		SchemaVersion: s.schemaVersion,
+		SearchID:      params.SearchID,
+		CreatedAt:     search.CreatedAt.UTC().Format(time.RFC3339),
+		Offers:        len(search.Offers),
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Restored search %s with %d offer(s).", params.SearchID, response.Offers)},
+		},
+	}
+	return result, response, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type serverInfoParams struct{}
+
+type featureResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+type serverInfoResponse struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Name          string            `json:"name"`
+	Version       string            `json:"version"`
+	Market        string            `json:"market"`
+	ReadOnly      bool              `json:"readOnly,omitempty"` // the tools changing watches, trips and saved searches are disabled
+	Features      []featureResponse `json:"features"`
+}
+
+func (s *server) serverInfo(_ context.Context, _ *mcp.CallToolRequest, _ serverInfoParams) (*mcp.CallToolResult, serverInfoResponse, error) {
+	response := serverInfoResponse{
+		SchemaVersion: s.schemaVersion,
+		Name:          serverName,
+		Version:       serverVersion,
+		Market:        s.marketName(),
+		ReadOnly:      s.readOnly,
+		Features:      make([]featureResponse, 0, len(knownFeatures)),
+	}
+	for _, f := range knownFeatures {
+		response.Features = append(response.Features, featureResponse{
+			Name:        f.Name,
+			Description: f.Description,
+			Enabled:     s.features.Enabled(f.Name),
+		})
+	}
+
+	enabled := "none"
+	if names := s.features.Names(); len(names) > 0 {
+		enabled = strings.Join(names, ", ")
+	}
+	text := fmt.Sprintf("%s %s, market %s. Enabled features: %s.", serverName, serverVersion, s.marketName(), enabled)
+	if s.readOnly {
+		text += " Read-only: watches, trips and saved searches can't be changed."
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: text},
+		},
+	}
+	return result, response, nil
+}
+
+// marketName returns the country of sale reported with prices.
+func (s *server) marketName() string {
+	if s.market == "" {
+		return "auto"
+	}
+	return s.market
+}
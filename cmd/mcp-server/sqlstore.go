@@ -0,0 +1,490 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/text/currency"
+)
+
+// instanceLease is how long the claim of a server instance on its database lasts
+// unless it's renewed, see sqlStore.Lock. An instance that stopped without closing
+// the store keeps the others from starting for as long.
+const instanceLease = 30 * time.Second
+
+// storedOffer is the JSON form of registeredOffer. currency.Unit doesn't marshal to
+// JSON, so the currency is stored by its ISO code.
+type storedOffer struct {
+	Args     flights.Args `json:"args"`
+	Currency string       `json:"currency"`
+	Price    float64      `json:"price"`
+}
+
+// sqlStore keeps the state in a SQLite or Postgres database, so that offer IDs,
+// search IDs, fare histories and watches survive restarts. It's bounded like the
+// memory store. The watches are saved as one list, which server instances sharing
+// the database would overwrite, so only one instance at a time can lock it.
+type sqlStore struct {
+	db      *sql.DB
+	backend string
+	owner   string // identifies the claim of Lock
+	version int    // schema version of the database, see migrate
+
+	unlock  context.CancelFunc // stops renewing the claim, nil until Lock
+	renewed chan struct{}      // closed when the claim isn't renewed anymore
+}
+
+// openSQLStore opens the database of the sqlite or postgres backend and applies the
+// migrations it lacks.
+func openSQLStore(backend, dsn string) (*sqlStore, error) {
+	s, err := openSQLDatabase(backend, dsn)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if s.version, err = s.migrate(ctx); err != nil {
+		s.db.Close()
+		return nil, fmt.Errorf("migrate %s store: %v", backend, err)
+	}
+	return s, nil
+}
+
+// openSQLDatabase opens the database of the sqlite or postgres backend as it is,
+// without applying migrations.
+func openSQLDatabase(backend, dsn string) (*sqlStore, error) {
+	driver := backend
+	if backend == "sqlite" {
+		driver = "sqlite3"
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open %s store: %v", backend, err)
+	}
+	if backend == "sqlite" {
+		// SQLite allows a single writer, concurrent transactions would fail as busy.
+		db.SetMaxOpenConns(1)
+	}
+	return &sqlStore{db: db, backend: backend, owner: newSearchID()}, nil
+}
+
+// Lock claims the database for this server instance and renews the claim until
+// Close. It fails if another instance renewed its claim within instanceLease.
+func (s *sqlStore) Lock(ctx context.Context) error {
+	ok, err := s.claim(ctx)
+	if err != nil {
+		return fmt.Errorf("lock %s store: %v", s.backend, err)
+	}
+	if !ok {
+		return fmt.Errorf("the %s store is in use by another server instance and instances can't share a database; if it stopped without closing the store, retry in %s", s.backend, instanceLease)
+	}
+	ctx, s.unlock = context.WithCancel(context.Background())
+	s.renewed = make(chan struct{})
+	go s.renew(ctx)
+	return nil
+}
+
+// claim claims the database unless another instance renewed its claim within
+// instanceLease, ok is false then.
+func (s *sqlStore) claim(ctx context.Context) (ok bool, err error) {
+	now := time.Now()
+	result, err := s.db.ExecContext(ctx, s.bind(`INSERT INTO instance_lock (id, owner, renewed_at) VALUES (1, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET owner = excluded.owner, renewed_at = excluded.renewed_at
+		WHERE instance_lock.owner = excluded.owner OR instance_lock.renewed_at < ?`),
+		s.owner, now.UnixNano(), now.Add(-instanceLease).UnixNano())
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// renew renews the claim of Lock until ctx is done.
+func (s *sqlStore) renew(ctx context.Context) {
+	defer close(s.renewed)
+	ticker := time.NewTicker(instanceLease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		ok, err := s.claim(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			// The claim outlasts failures shorter than the lease.
+			log.Printf("renew %s store lock: %v", s.backend, err)
+		case !ok:
+			// Another instance took over while the database was unreachable, the
+			// watches of either would be overwritten.
+			log.Fatalf("the %s store was locked by another server instance", s.backend)
+		}
+	}
+}
+
+// Close releases the claim of Lock and closes the database.
+func (s *sqlStore) Close() error {
+	if s.unlock != nil {
+		s.unlock()
+		<-s.renewed
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := s.db.ExecContext(ctx, s.bind(`DELETE FROM instance_lock WHERE owner = ?`), s.owner); err != nil {
+			log.Printf("unlock %s store: %v", s.backend, err)
+		}
+	}
+	return s.db.Close()
+}
+
+// bind rewrites the ? placeholders of query to the $1 style Postgres expects.
+func (s *sqlStore) bind(query string) string {
+	if s.backend != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (s *sqlStore) exec(ctx context.Context, tx *sql.Tx, query string, args ...any) error {
+	_, err := tx.ExecContext(ctx, s.bind(query), args...)
+	return err
+}
+
+// inTx runs f in a transaction, which is committed if f succeeds.
+func (s *sqlStore) inTx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) SaveOffer(ctx context.Context, id string, offer registeredOffer) error {
+	data, err := json.Marshal(storedOffer{Args: offer.Args, Currency: offer.Args.Currency.String(), Price: offer.Price})
+	if err != nil {
+		return err
+	}
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		err := s.exec(ctx, tx, `INSERT INTO offers (id, stored_at, offer) VALUES (?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET stored_at = excluded.stored_at, offer = excluded.offer`,
+			id, time.Now().UnixNano(), string(data))
+		if err != nil {
+			return err
+		}
+		return s.exec(ctx, tx, `DELETE FROM offers WHERE stored_at <
+			(SELECT stored_at FROM offers ORDER BY stored_at DESC LIMIT 1 OFFSET ?)`, maxRegisteredOffers-1)
+	})
+}
+
+func (s *sqlStore) Offer(ctx context.Context, id string) (registeredOffer, bool, error) {
+	var data string
+	err := s.db.QueryRowContext(ctx, s.bind(`SELECT offer FROM offers WHERE id = ?`), id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return registeredOffer{}, false, nil
+	}
+	if err != nil {
+		return registeredOffer{}, false, err
+	}
+	var stored storedOffer
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
+		return registeredOffer{}, false, fmt.Errorf("parse offer %s: %v", id, err)
+	}
+	if stored.Args.Currency, err = currency.ParseISO(stored.Currency); err != nil {
+		return registeredOffer{}, false, fmt.Errorf("parse offer %s: %v", id, err)
+	}
+	return registeredOffer{Args: stored.Args, Price: stored.Price}, true, nil
+}
+
+func (s *sqlStore) SaveSearch(ctx context.Context, offers []offerResponse) (string, error) {
+	data, err := json.Marshal(offers)
+	if err != nil {
+		return "", err
+	}
+	id := newSearchID()
+	err = s.inTx(ctx, func(tx *sql.Tx) error {
+		err := s.exec(ctx, tx, `INSERT INTO searches (id, created_at, offers) VALUES (?, ?, ?)`,
+			id, time.Now().UnixNano(), string(data))
+		if err != nil {
+			return err
+		}
+		return s.exec(ctx, tx, `DELETE FROM searches WHERE created_at <
+			(SELECT created_at FROM searches ORDER BY created_at DESC LIMIT 1 OFFSET ?)`, maxRegisteredSearches-1)
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// loadSearch returns the search with the given ID, deleted or not.
+func (s *sqlStore) loadSearch(query func(string, ...any) *sql.Row, id string) (registeredSearch, bool, error) {
+	var search registeredSearch
+	var createdAt, deletedAt int64
+	var data string
+	err := query(s.bind(`SELECT created_at, deleted_at, offers FROM searches WHERE id = ?`), id).Scan(&createdAt, &deletedAt, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return search, false, nil
+	}
+	if err != nil {
+		return search, false, err
+	}
+	if err := json.Unmarshal([]byte(data), &search.Offers); err != nil {
+		return search, false, fmt.Errorf("parse search %s: %v", id, err)
+	}
+	search.CreatedAt = time.Unix(0, createdAt)
+	if deletedAt != 0 {
+		search.DeletedAt = time.Unix(0, deletedAt)
+	}
+	return search, true, nil
+}
+
+func (s *sqlStore) Search(ctx context.Context, id string) (registeredSearch, bool, error) {
+	query := func(q string, args ...any) *sql.Row { return s.db.QueryRowContext(ctx, q, args...) }
+	search, ok, err := s.loadSearch(query, id)
+	if err != nil || !ok || !search.DeletedAt.IsZero() {
+		return registeredSearch{}, false, err
+	}
+	return search, true, nil
+}
+
+func (s *sqlStore) DeleteSearch(ctx context.Context, id string) (bool, error) {
+	result, err := s.db.ExecContext(ctx, s.bind(`UPDATE searches SET deleted_at = ? WHERE id = ? AND deleted_at = 0`),
+		time.Now().UnixNano(), id)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+func (s *sqlStore) RestoreSearch(ctx context.Context, id string) (registeredSearch, bool, error) {
+	var search registeredSearch
+	ok := false
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		query := func(q string, args ...any) *sql.Row { return tx.QueryRowContext(ctx, q, args...) }
+		var err error
+		search, ok, err = s.loadSearch(query, id)
+		if err != nil || !ok {
+			return err
+		}
+		if search.DeletedAt.IsZero() || time.Since(search.DeletedAt) >= deletedRetention {
+			ok = false
+			return nil
+		}
+		search.DeletedAt = time.Time{}
+		return s.exec(ctx, tx, `UPDATE searches SET deleted_at = 0 WHERE id = ?`, id)
+	})
+	if err != nil || !ok {
+		return registeredSearch{}, false, err
+	}
+	return search, true, nil
+}
+
+func (s *sqlStore) ObserveFare(ctx context.Context, key string, point pricePoint) ([]pricePoint, error) {
+	var points []pricePoint
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		// The row is created first, so that concurrent observations of a new fare lock
+		// the same row rather than both inserting it.
+		err := s.exec(ctx, tx, `INSERT INTO fares (fare_key, observed_at, points) VALUES (?, ?, '[]')
+			ON CONFLICT (fare_key) DO NOTHING`, key, time.Now().UnixNano())
+		if err != nil {
+			return err
+		}
+		query := `SELECT points FROM fares WHERE fare_key = ?`
+		if s.backend == "postgres" {
+			query += ` FOR UPDATE`
+		}
+		var data string
+		if err := tx.QueryRowContext(ctx, s.bind(query), key).Scan(&data); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(data), &points); err != nil {
+			return fmt.Errorf("parse fare %s: %v", key, err)
+		}
+		points = appendFarePoint(points, point)
+		encoded, err := json.Marshal(points)
+		if err != nil {
+			return err
+		}
+		err = s.exec(ctx, tx, `UPDATE fares SET observed_at = ?, points = ? WHERE fare_key = ?`,
+			time.Now().UnixNano(), string(encoded), key)
+		if err != nil {
+			return err
+		}
+		return s.exec(ctx, tx, `DELETE FROM fares WHERE observed_at <
+			(SELECT observed_at FROM fares ORDER BY observed_at DESC LIMIT 1 OFFSET ?)`, maxObservedFares-1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+func (s *sqlStore) LoadWatches(ctx context.Context) (watchListFile, error) {
+	return loadWatches(func(q string, args ...any) *sql.Row { return s.db.QueryRowContext(ctx, q, args...) })
+}
+
+// loadWatches returns the saved watches, an empty list if none were saved yet.
+func loadWatches(query func(string, ...any) *sql.Row) (watchListFile, error) {
+	var watches watchListFile
+	var data string
+	err := query(`SELECT watches FROM watch_list WHERE id = 1`).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return watches, nil
+	}
+	if err != nil {
+		return watches, err
+	}
+	if err := json.Unmarshal([]byte(data), &watches); err != nil {
+		return watches, fmt.Errorf("parse watches: %v", err)
+	}
+	return watches, nil
+}
+
+func (s *sqlStore) SaveWatches(ctx context.Context, watches watchListFile) error {
+	data, err := json.Marshal(watches)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.bind(`INSERT INTO watch_list (id, watches) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET watches = excluded.watches`), string(data))
+	return err
+}
+
+// Dump reads all tables in one transaction, so that the dump is consistent.
+func (s *sqlStore) Dump(ctx context.Context) (storeDump, error) {
+	var dump storeDump
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		err := queryRows(ctx, tx, `SELECT id, stored_at, offer FROM offers ORDER BY stored_at`, func(rows *sql.Rows) error {
+			var o dumpedOffer
+			var storedAt int64
+			var data string
+			if err := rows.Scan(&o.ID, &storedAt, &data); err != nil {
+				return err
+			}
+			o.StoredAt, o.Offer = time.Unix(0, storedAt), json.RawMessage(data)
+			dump.Offers = append(dump.Offers, o)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		err = queryRows(ctx, tx, `SELECT id, created_at, deleted_at, offers FROM searches ORDER BY created_at`, func(rows *sql.Rows) error {
+			var search dumpedSearch
+			var createdAt, deletedAt int64
+			var data string
+			if err := rows.Scan(&search.ID, &createdAt, &deletedAt, &data); err != nil {
+				return err
+			}
+			search.CreatedAt, search.Offers = time.Unix(0, createdAt), json.RawMessage(data)
+			if deletedAt != 0 {
+				search.DeletedAt = time.Unix(0, deletedAt)
+			}
+			dump.Searches = append(dump.Searches, search)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		err = queryRows(ctx, tx, `SELECT fare_key, observed_at, points FROM fares ORDER BY observed_at`, func(rows *sql.Rows) error {
+			var fare dumpedFare
+			var observedAt int64
+			var data string
+			if err := rows.Scan(&fare.Key, &observedAt, &data); err != nil {
+				return err
+			}
+			fare.ObservedAt, fare.Points = time.Unix(0, observedAt), json.RawMessage(data)
+			dump.Fares = append(dump.Fares, fare)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		dump.Watches, err = loadWatches(func(q string, args ...any) *sql.Row { return tx.QueryRowContext(ctx, q, args...) })
+		return err
+	})
+	return dump, err
+}
+
+// queryRows calls scan for every row of query.
+func queryRows(ctx context.Context, tx *sql.Tx, query string, scan func(*sql.Rows) error) error {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Restore replaces all tables in one transaction, so that a failed restore leaves
+// the state as it was.
+func (s *sqlStore) Restore(ctx context.Context, dump storeDump) error {
+	watches, err := json.Marshal(dump.Watches)
+	if err != nil {
+		return err
+	}
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		for _, table := range []string{"offers", "searches", "fares", "watch_list"} {
+			if err := s.exec(ctx, tx, `DELETE FROM `+table); err != nil {
+				return err
+			}
+		}
+		for _, o := range dump.Offers {
+			err := s.exec(ctx, tx, `INSERT INTO offers (id, stored_at, offer) VALUES (?, ?, ?)`,
+				o.ID, o.StoredAt.UnixNano(), string(o.Offer))
+			if err != nil {
+				return fmt.Errorf("restore offer %s: %v", o.ID, err)
+			}
+		}
+		for _, search := range dump.Searches {
+			var deletedAt int64
+			if !search.DeletedAt.IsZero() {
+				deletedAt = search.DeletedAt.UnixNano()
+			}
+			err := s.exec(ctx, tx, `INSERT INTO searches (id, created_at, deleted_at, offers) VALUES (?, ?, ?, ?)`,
+				search.ID, search.CreatedAt.UnixNano(), deletedAt, string(search.Offers))
+			if err != nil {
+				return fmt.Errorf("restore search %s: %v", search.ID, err)
+			}
+		}
+		for _, fare := range dump.Fares {
+			err := s.exec(ctx, tx, `INSERT INTO fares (fare_key, observed_at, points) VALUES (?, ?, ?)`,
+				fare.Key, fare.ObservedAt.UnixNano(), string(fare.Points))
+			if err != nil {
+				return fmt.Errorf("restore fare %s: %v", fare.Key, err)
+			}
+		}
+		return s.exec(ctx, tx, `INSERT INTO watch_list (id, watches) VALUES (1, ?)`, string(watches))
+	})
+}
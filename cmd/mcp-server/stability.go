@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// fareTolerance is the relative price change up to which two prices of a fare are
+// considered the same fare.
+const fareTolerance = 0.05
+
+// fareKey identifies the fare of an offer across searches: its itinerary, see
+// cheapoffers.OfferID, and the options that change its price.
+func fareKey(offerID string, options flights.Options) string {
+	return fmt.Sprintf("%s/%s/%d/%d/%d/%v", offerID, options.Currency, options.Class, options.Stops, options.TripType, options.Travelers)
+}
+
+// estimateStability estimates how long a price of a fare lasts from its prices,
+// oldest first. A price lasts until a later one differs by more than fareTolerance.
+// If no price change was observed, held is true and the estimate is how long the
+// price has held so far. ok is false without enough history.
+func estimateStability(points []pricePoint) (lasted time.Duration, held, ok bool) {
+	if len(points) < 2 {
+		return 0, false, false
+	}
+	start := points[0]
+	var total time.Duration
+	changes := 0
+	for _, p := range points[1:] {
+		if math.Abs(p.Price-start.Price) > fareTolerance*start.Price {
+			total += p.At.Sub(start.At)
+			changes++
+			start = p
+		}
+	}
+	if changes > 0 {
+		return total / time.Duration(changes), false, true
+	}
+	span := points[len(points)-1].At.Sub(points[0].At)
+	if span < 24*time.Hour {
+		return 0, false, false
+	}
+	return span, true, true
+}
+
+// stabilityHint phrases the estimate of estimateStability for estimatedStability.
+func stabilityHint(lasted time.Duration, held bool) string {
+	var approx string
+	if lasted >= 36*time.Hour {
+		approx = fmt.Sprintf("%d days", int(math.Round(lasted.Hours()/24)))
+	} else {
+		approx = fmt.Sprintf("%d hours", max(int(math.Round(lasted.Hours())), 1))
+	}
+	if held {
+		return "the fare has held for at least " + approx
+	}
+	return "similar fares lasted ~" + approx
+}
+
+// observeFare records the price of an offer found with options and returns its
+// estimatedStability, empty without enough history.
+func (s *server) observeFare(ctx context.Context, offerID string, options flights.Options, price float64, at time.Time) (string, error) {
+	points, err := s.store.ObserveFare(ctx, fareKey(offerID, options), pricePoint{At: at, Price: price})
+	if err != nil {
+		return "", fmt.Errorf("observe fare: %w", err)
+	}
+	lasted, held, ok := estimateStability(points)
+	if !ok {
+		return "", nil
+	}
+	return stabilityHint(lasted, held), nil
+}
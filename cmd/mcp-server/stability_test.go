@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"golang.org/x/text/currency"
+)
+
+func TestEstimateStability(t *testing.T) {
+	at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name   string
+		points []pricePoint
+		want   string
+	}{
+		{"no history", []pricePoint{{at, 300}}, ""},
+		{"held less than a day", []pricePoint{{at, 300}, {at.Add(6 * time.Hour), 305}}, ""},
+		{"held", []pricePoint{{at, 300}, {at.Add(day), 305}, {at.Add(3 * day), 296}}, "the fare has held for at least 3 days"},
+		{
+			"changes",
+			[]pricePoint{{at, 300}, {at.Add(day), 310}, {at.Add(2 * day), 350}, {at.Add(3 * day), 352}, {at.Add(4 * day), 290}},
+			"similar fares lasted ~2 days",
+		},
+		{"hours", []pricePoint{{at, 300}, {at.Add(5 * time.Hour), 400}}, "similar fares lasted ~5 hours"},
+	}
+	for _, tt := range tests {
+		got := ""
+		if lasted, held, ok := estimateStability(tt.points); ok {
+			got = stabilityHint(lasted, held)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestObserveFare(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	options := flights.OptionsDefault()
+	ctx := context.Background()
+	at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	for i, price := range []float64{300, 400, 400, 300} {
+		hint, err := s.observeFare(ctx, "abc", options, price, at.Add(time.Duration(i)*48*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 && hint != "" {
+			t.Fatalf("expected no hint for a new fare, got %q", hint)
+		}
+		if i == 3 && hint != "similar fares lasted ~3 days" {
+			t.Fatalf("wrong hint: %q", hint)
+		}
+	}
+	// The same price returned again from the cache isn't another observation.
+	points, err := s.store.ObserveFare(ctx, fareKey("abc", options), pricePoint{At: at.Add(6 * 24 * time.Hour), Price: 300})
+	if err != nil || len(points) != 4 {
+		t.Fatalf("expected 4 observations, got %v, %v", points, err)
+	}
+
+	// Another currency is another fare.
+	options.Currency = currency.EUR
+	hint, err := s.observeFare(ctx, "abc", options, 300, at)
+	if err != nil || hint != "" {
+		t.Fatalf("expected no hint for another currency, got %q, %v", hint, err)
+	}
+}
+
+func TestFareRegistryEvictsLeastRecent(t *testing.T) {
+	r := newFareRegistry()
+	at := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := range maxObservedFares {
+		r.Observe(strconv.Itoa(i), pricePoint{At: at, Price: 100})
+	}
+	// Observing the oldest fare again keeps it, the next oldest is evicted instead.
+	r.Observe("0", pricePoint{At: at.Add(time.Hour), Price: 100})
+	r.Observe("new", pricePoint{At: at, Price: 100})
+
+	if _, ok := r.fares["1"]; ok {
+		t.Fatal("expected the least recently observed fare to be evicted")
+	}
+	if points := r.Observe("0", pricePoint{At: at.Add(2 * time.Hour), Price: 100}); len(points) != 3 {
+		t.Fatalf("expected the 3 prices of the observed fare, got %v", points)
+	}
+	if r.order.Len() != maxObservedFares || len(r.fares) != maxObservedFares {
+		t.Fatalf("expected %d fares, got %d and %d", maxObservedFares, r.order.Len(), len(r.fares))
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"time"
+)
+
+// stateDump is the internal state of the server written on SIGUSR1, so that
+// operators can inspect a process that stopped answering.
+type stateDump struct {
+	Time       string             `json:"time"`
+	Goroutines int                `json:"goroutines"`
+	Session    *readinessResponse `json:"session,omitempty"` // omitted if the session isn't warmed up
+	Searches   searchesResponse   `json:"searches"`
+	Quota      quotaState         `json:"quota"`
+	Watches    watchesState       `json:"watches"`
+	Cache      *cacheState        `json:"cache,omitempty"` // omitted if caching is disabled
+}
+
+// quotaState is the state of the daily request quota, which limits the upstream
+// requests.
+type quotaState struct {
+	Used       int    `json:"used"`
+	DailyQuota int    `json:"dailyQuota"` // 0 if unlimited
+	ResetsAt   string `json:"resetsAt"`
+	InFlight   int    `json:"inFlight"`
+}
+
+// watchesState is the queue of the watch checks.
+type watchesState struct {
+	Active  int    `json:"active"`
+	Paused  int    `json:"paused"`
+	Expired int    `json:"expired"`                 // kept until they're cleaned up
+	Oldest  string `json:"oldestCheckAt,omitempty"` // last check of the active watch checked longest ago
+}
+
+type cacheState struct {
+	Entries    int `json:"entries"`
+	Warm       int `json:"warm"`
+	Hot        int `json:"hot"`
+	Refreshing int `json:"refreshing"`
+	Hits       int `json:"hits"`
+	Misses     int `json:"misses"`
+}
+
+func (s *server) stateDump(now time.Time) stateDump {
+	dump := stateDump{
+		Time:       now.UTC().Format(time.RFC3339),
+		Goroutines: runtime.NumGoroutine(),
+		Searches:   s.searchesStatus(),
+	}
+	if s.warm != nil {
+		status := s.warm.status()
+		dump.Session = &status
+	}
+
+	s.usage.mu.Lock()
+	s.usage.rollOver()
+	dump.Quota = quotaState{
+		Used:       s.usage.used,
+		DailyQuota: s.usage.dailyQuota,
+		ResetsAt:   s.usage.day.Add(24 * time.Hour).Format(time.RFC3339),
+		InFlight:   s.usage.inFlight,
+	}
+	s.usage.mu.Unlock()
+
+	watches, _ := s.watches.list()
+	var oldest time.Time
+	for _, w := range watches {
+		switch {
+		case !w.ExpiredAt.IsZero():
+			dump.Watches.Expired++
+		case w.Paused:
+			dump.Watches.Paused++
+		default:
+			dump.Watches.Active++
+			if oldest.IsZero() || w.LastCheckedAt.Before(oldest) {
+				oldest = w.LastCheckedAt
+			}
+		}
+	}
+	if !oldest.IsZero() {
+		dump.Watches.Oldest = oldest.Format(time.RFC3339)
+	}
+
+	if s.cache != nil {
+		stats := s.cache.Stats()
+		dump.Cache = &cacheState{
+			Entries:    stats.Entries,
+			Warm:       stats.Warm,
+			Hot:        stats.Hot,
+			Refreshing: stats.Refreshing,
+			Hits:       stats.Hits,
+			Misses:     stats.Misses,
+		}
+	}
+	return dump
+}
+
+// dumpStateOnSignal writes the state dump every time the process receives SIGUSR1
+// until ctx is done: to path if set, replacing the previous dump, to the log
+// otherwise.
+func (s *server) dumpStateOnSignal(ctx context.Context, path string) {
+	signals := make(chan os.Signal, 1)
+	if !notifyStateDump(signals) {
+		return
+	}
+	defer signal.Stop(signals)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			if err := s.writeStateDump(path); err != nil {
+				log.Printf("dump state: %v", err)
+			}
+		}
+	}
+}
+
+func (s *server) writeStateDump(path string) error {
+	dump := s.stateDump(time.Now())
+	if path == "" {
+		data, err := json.Marshal(dump)
+		if err != nil {
+			return err
+		}
+		log.Printf("state dump: %s", data)
+		return nil
+	}
+	data, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return err
+	}
+	log.Printf("state dumped to %s", path)
+	return nil
+}
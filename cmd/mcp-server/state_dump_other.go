@@ -0,0 +1,10 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// notifyStateDump reports that there is no SIGUSR1 to dump the state on.
+func notifyStateDump(chan<- os.Signal) bool {
+	return false
+}
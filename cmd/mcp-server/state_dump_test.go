@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+)
+
+func TestWriteStateDump(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	s.cache = cheapoffers.NewCache(time.Minute)
+	_, done := s.tracker.Start("find_cheapest_offers", "SFO -> JFK")
+	defer done()
+	checkedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, w := range []priceWatch{
+		{ID: "a", LastCheckedAt: checkedAt},
+		{ID: "b", LastCheckedAt: checkedAt.Add(time.Hour)},
+		{ID: "c", Paused: true},
+	} {
+		if err := s.watches.add(w); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := s.writeStateDump(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dump stateDump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		t.Fatal(err)
+	}
+	if len(dump.Searches.Searches) != 1 || dump.Searches.Searches[0].Route != "SFO -> JFK" {
+		t.Fatalf("expected the running search, got %+v", dump.Searches)
+	}
+	if dump.Watches != (watchesState{Active: 2, Paused: 1, Oldest: "2026-01-02T03:04:05Z"}) {
+		t.Fatalf("wrong watch state: %+v", dump.Watches)
+	}
+	if dump.Cache == nil || dump.Session != nil || dump.Goroutines == 0 {
+		t.Fatalf("wrong state dump: %s", data)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyStateDump relays SIGUSR1 to signals.
+func notifyStateDump(signals chan<- os.Signal) bool {
+	signal.Notify(signals, syscall.SIGUSR1)
+	return true
+}
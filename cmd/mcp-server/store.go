@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+)
+
+// deletedRetention is how long deleted watches and searches can be restored.
+const deletedRetention = 7 * 24 * time.Hour
+
+// store holds the state that follow-up tool calls refer to: the offers returned to
+// clients and the results of recent searches, as well as the price watches. Persistent
+// backends can fail, so every method returns an error.
+type store interface {
+	watchStore
+
+	SaveOffer(ctx context.Context, id string, offer registeredOffer) error
+	// Offer returns the offer with the given ID, ok is false if it's unknown or expired.
+	Offer(ctx context.Context, id string) (offer registeredOffer, ok bool, err error)
+	// SaveSearch stores the offers of a search and returns the new search ID.
+	SaveSearch(ctx context.Context, offers []offerResponse) (string, error)
+	// Search returns the search with the given ID, ok is false if it's unknown, expired
+	// or deleted.
+	Search(ctx context.Context, id string) (search registeredSearch, ok bool, err error)
+	// DeleteSearch deletes a search, which RestoreSearch can undo for deletedRetention.
+	// ok is false if it's unknown, expired or already deleted.
+	DeleteSearch(ctx context.Context, id string) (ok bool, err error)
+	// RestoreSearch restores a search deleted less than deletedRetention ago and
+	// returns it, ok is false if there's no such search.
+	RestoreSearch(ctx context.Context, id string) (search registeredSearch, ok bool, err error)
+	// ObserveFare records the price of a fare, see fareKey, and returns all its
+	// remembered prices, oldest first.
+	ObserveFare(ctx context.Context, key string, point pricePoint) ([]pricePoint, error)
+
+	// Dump returns the whole state of the store for a backup.
+	Dump(ctx context.Context) (storeDump, error)
+	// Restore replaces the whole state of the store with a dump.
+	Restore(ctx context.Context, dump storeDump) error
+
+	// Lock claims the store for a serving server instance. A persistent store fails
+	// if another instance holds it, as instances sharing it would overwrite each
+	// other's watches.
+	Lock(ctx context.Context) error
+	// Close releases the claim of Lock and the resources of the store.
+	Close() error
+}
+
+// watchStore persists the price watches, the deleted watches and their events. The
+// watch list is small and changes as a whole, so it's loaded and saved as one.
+type watchStore interface {
+	// LoadWatches returns the saved watches, an empty list if none were saved yet.
+	LoadWatches(ctx context.Context) (watchListFile, error)
+	SaveWatches(ctx context.Context, watches watchListFile) error
+}
+
+// storeDump is the state of a store as backed up. Offers, searches and fare prices
+// keep the JSON the sql stores hold them as.
+type storeDump struct {
+	Offers   []dumpedOffer  `json:"offers"`   // oldest first
+	Searches []dumpedSearch `json:"searches"` // oldest first
+	Fares    []dumpedFare   `json:"fares"`    // least recently observed first
+	Watches  watchListFile  `json:"watches"`
+}
+
+type dumpedOffer struct {
+	ID       string          `json:"id"`
+	StoredAt time.Time       `json:"storedAt"`
+	Offer    json.RawMessage `json:"offer"` // storedOffer
+}
+
+type dumpedSearch struct {
+	ID        string          `json:"id"`
+	CreatedAt time.Time       `json:"createdAt"`
+	DeletedAt time.Time       `json:"deletedAt"` // zero unless the search is deleted
+	Offers    json.RawMessage `json:"offers"`    // []offerResponse
+}
+
+type dumpedFare struct {
+	Key        string          `json:"key"`
+	ObservedAt time.Time       `json:"observedAt"`
+	Points     json.RawMessage `json:"points"` // []pricePoint
+}
+
+// storeBackends lists the store backends selectable with --store.
+var storeBackends = []string{"memory", "sqlite", "postgres"}
+
+// storeConfig selects and configures the store backend.
+type storeConfig struct {
+	Backend string
+	// DSN is the database of the sqlite and postgres backends: the path of the SQLite
+	// file or the connection string of the Postgres database. The connection string
+	// holds the password, so it may be a secret reference, see resolveSecret.
+	DSN string
+	// WatchFile is the JSON file the memory backend persists the watches to, empty
+	// keeps them in memory. The other backends keep the watches in their database.
+	WatchFile string
+}
+
+// newStore creates the store backend of the config.
+func newStore(config storeConfig) (store, error) {
+	switch config.Backend {
+	case "memory":
+		return newMemoryStore(config.WatchFile), nil
+	case "sqlite", "postgres":
+		dsn, err := config.database()
+		if err != nil {
+			return nil, err
+		}
+		return openSQLStore(config.Backend, dsn)
+	default:
+		return nil, fmt.Errorf("unknown store %q, available: %v", config.Backend, storeBackends)
+	}
+}
+
+// database returns the database of the sqlite and postgres backends, with the
+// secret references of a Postgres connection string resolved.
+func (config storeConfig) database() (string, error) {
+	if config.WatchFile != "" {
+		return "", fmt.Errorf("the %s store keeps the watches in its database, a watch file only applies to the memory store", config.Backend)
+	}
+	if config.DSN == "" {
+		return "", fmt.Errorf("the %s store needs a database, set --store-dsn", config.Backend)
+	}
+	if config.Backend != "postgres" {
+		return config.DSN, nil
+	}
+	dsn, err := resolveSecret(config.DSN)
+	if err != nil {
+		return "", fmt.Errorf("resolve store dsn: %w", err)
+	}
+	return dsn, nil
+}
+
+// memoryStore keeps the state in bounded in-memory registries. It's lost on restart,
+// except for the watches if they're persisted to a watch file.
+type memoryStore struct {
+	offers    *offerRegistry
+	searches  *searchRegistry
+	fares     *fareRegistry
+	watchFile string
+
+	mu      sync.Mutex
+	watches watchListFile // saved watches, unless there's a watch file
+}
+
+func newMemoryStore(watchFile string) *memoryStore {
+	return &memoryStore{offers: newOfferRegistry(), searches: newSearchRegistry(), fares: newFareRegistry(), watchFile: watchFile}
+}
+
+func (m *memoryStore) SaveOffer(_ context.Context, id string, offer registeredOffer) error {
+	m.offers.Store(id, offer)
+	return nil
+}
+
+func (m *memoryStore) Offer(_ context.Context, id string) (registeredOffer, bool, error) {
+	offer, ok := m.offers.Load(id)
+	return offer, ok, nil
+}
+
+func (m *memoryStore) SaveSearch(_ context.Context, offers []offerResponse) (string, error) {
+	return m.searches.Store(offers), nil
+}
+
+func (m *memoryStore) Search(_ context.Context, id string) (registeredSearch, bool, error) {
+	search, ok := m.searches.Load(id)
+	return search, ok, nil
+}
+
+func (m *memoryStore) DeleteSearch(_ context.Context, id string) (bool, error) {
+	return m.searches.Delete(id, time.Now()), nil
+}
+
+func (m *memoryStore) RestoreSearch(_ context.Context, id string) (registeredSearch, bool, error) {
+	search, ok := m.searches.Restore(id, time.Now())
+	return search, ok, nil
+}
+
+func (m *memoryStore) ObserveFare(_ context.Context, key string, point pricePoint) ([]pricePoint, error) {
+	return m.fares.Observe(key, point), nil
+}
+
+// Dump only returns the watches. The other state of the memory store is lost with
+// the process, so a backup process has none.
+func (m *memoryStore) Dump(ctx context.Context) (storeDump, error) {
+	watches, err := m.LoadWatches(ctx)
+	return storeDump{Watches: watches}, err
+}
+
+// Restore only restores the watches, see Dump.
+func (m *memoryStore) Restore(ctx context.Context, dump storeDump) error {
+	return m.SaveWatches(ctx, dump.Watches)
+}
+
+// Lock doesn't claim the watch file, which is meant for a single instance.
+func (m *memoryStore) Lock(context.Context) error {
+	return nil
+}
+
+func (m *memoryStore) Close() error {
+	return nil
+}
+
+// LoadWatches reads the watch file. A missing file starts without watches.
+func (m *memoryStore) LoadWatches(_ context.Context) (watchListFile, error) {
+	if m.watchFile == "" {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return m.watches, nil
+	}
+	var watches watchListFile
+	data, err := os.ReadFile(m.watchFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return watches, nil
+	}
+	if err != nil {
+		return watches, err
+	}
+	if err := json.Unmarshal(data, &watches); err != nil {
+		return watches, fmt.Errorf("parse %s: %v", m.watchFile, err)
+	}
+	return watches, nil
+}
+
+// SaveWatches writes the watch file. The file is replaced atomically, so that a
+// crash while writing doesn't lose all watches.
+func (m *memoryStore) SaveWatches(_ context.Context, watches watchListFile) error {
+	if m.watchFile == "" {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.watches = watches
+		return nil
+	}
+	data, err := json.MarshalIndent(watches, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := m.watchFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.watchFile)
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"golang.org/x/text/currency"
+)
+
+// postgresDSNEnv names the environment variable with the connection string of a
+// scratch Postgres database the postgres store is tested against, if set.
+const postgresDSNEnv = "TEST_POSTGRES_DSN"
+
+func TestMemoryStore(t *testing.T) {
+	st, err := newStore(storeConfig{Backend: "memory"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, st)
+}
+
+func TestSQLiteStore(t *testing.T) {
+	config := storeConfig{Backend: "sqlite", DSN: filepath.Join(t.TempDir(), "store.db")}
+	st, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, st)
+	testStoreReopen(t, config)
+}
+
+func TestPostgresStore(t *testing.T) {
+	dsn := os.Getenv(postgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDSNEnv)
+	}
+	config := storeConfig{Backend: "postgres", DSN: dsn}
+	st, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, st)
+	testStoreReopen(t, config)
+}
+
+// testStore tests the behavior all store backends share.
+func testStore(t *testing.T, st store) {
+	ctx := context.Background()
+
+	args := flights.Args{Date: time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC), SrcAirports: []string{"BER"}, DstAirports: []string{"FCO"}, Options: flights.OptionsDefault()}
+	args.Currency = currency.EUR
+	if err := st.SaveOffer(ctx, "offer-1", registeredOffer{Args: args, Price: 199}); err != nil {
+		t.Fatal(err)
+	}
+	offer, ok, err := st.Offer(ctx, "offer-1")
+	if err != nil || !ok || offer.Price != 199 || offer.Args.Currency != currency.EUR || !reflect.DeepEqual(offer.Args.DstAirports, args.DstAirports) {
+		t.Fatalf("unexpected offer: %+v %v %v", offer, ok, err)
+	}
+	if _, ok, _ := st.Offer(ctx, "offer-2"); ok {
+		t.Fatal("expected an unknown offer")
+	}
+
+	id, err := st.SaveSearch(ctx, []offerResponse{{ID: "offer-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	search, ok, err := st.Search(ctx, id)
+	if err != nil || !ok || len(search.Offers) != 1 {
+		t.Fatalf("unexpected search: %+v %v %v", search, ok, err)
+	}
+
+	if ok, err := st.DeleteSearch(ctx, id); err != nil || !ok {
+		t.Fatalf("expected the search to be deleted: %v %v", ok, err)
+	}
+	if _, ok, _ := st.Search(ctx, id); ok {
+		t.Fatal("expected the deleted search to be gone")
+	}
+	if ok, _ := st.DeleteSearch(ctx, id); ok {
+		t.Fatal("expected a deleted search not to be deleted again")
+	}
+	if search, ok, err := st.RestoreSearch(ctx, id); err != nil || !ok || len(search.Offers) != 1 {
+		t.Fatalf("unexpected restored search: %+v %v %v", search, ok, err)
+	}
+	if _, ok, _ := st.Search(ctx, id); !ok {
+		t.Fatal("expected the restored search")
+	}
+	if _, ok, _ := st.RestoreSearch(ctx, id); ok {
+		t.Fatal("expected a search that isn't deleted not to be restored")
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	key := "fare-" + newSearchID()
+	for i, price := range []float64{120, 110} {
+		if _, err := st.ObserveFare(ctx, key, pricePoint{At: now.Add(time.Duration(i) * time.Hour), Price: price}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	points, err := st.ObserveFare(ctx, key, pricePoint{At: now.Add(time.Hour), Price: 110})
+	if err != nil || len(points) != 2 || points[0].Price != 120 || points[1].Price != 110 {
+		t.Fatalf("unexpected fare prices: %+v %v", points, err)
+	}
+
+	if watches, err := st.LoadWatches(ctx); err != nil || len(watches.Watches) != 0 {
+		t.Fatalf("expected no watches: %+v %v", watches, err)
+	}
+	saved := watchListFile{Watches: []priceWatch{{ID: "abc", Params: watchParams(), LastPrice: 240}}, Events: []watchEvent{{WatchID: "abc", Price: 240}}}
+	if err := st.SaveWatches(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	if watches, err := st.LoadWatches(ctx); err != nil || len(watches.Watches) != 1 || watches.Watches[0].LastPrice != 240 || len(watches.Events) != 1 {
+		t.Fatalf("unexpected watches: %+v %v", watches, err)
+	}
+}
+
+// testStoreReopen tests that a persistent store keeps the offers and watches saved
+// by testStore across restarts.
+func testStoreReopen(t *testing.T, config storeConfig) {
+	st, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := st.Offer(context.Background(), "offer-1"); err != nil || !ok {
+		t.Fatalf("expected the offer to survive a restart: %v %v", ok, err)
+	}
+	watches, err := loadWatchList(context.Background(), st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listed, _ := watches.list(); len(listed) != 1 || listed[0].ID != "abc" {
+		t.Fatalf("expected the watch to survive a restart: %+v", listed)
+	}
+}
+
+func TestSQLiteStoreMigrations(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "store.db")
+
+	// A database of a server predating the migrations has the tables of the first
+	// migration, but no schema_migrations table.
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(migrations[0].statements); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO offers (id, stored_at, offer) VALUES ('offer-1', 1, '{}')`); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	st, err := openSQLStore("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.version != len(migrations) {
+		t.Fatalf("expected schema version %d, got %d", len(migrations), st.version)
+	}
+	var offers int
+	if err := st.db.QueryRow(`SELECT COUNT(*) FROM offers`).Scan(&offers); err != nil || offers != 1 {
+		t.Fatalf("expected the offer to survive the migrations: %d %v", offers, err)
+	}
+	// A database of a newer server isn't opened.
+	if _, err := st.db.Exec(`INSERT INTO schema_migrations (version, applied_at) VALUES (?, 0)`, len(migrations)+1); err != nil {
+		t.Fatal(err)
+	}
+	st.Close()
+	if _, err := openSQLStore("sqlite", path); err == nil {
+		t.Fatal("expected an error for a database with a newer schema")
+	}
+}
+
+func TestSQLiteStoreLock(t *testing.T) {
+	ctx := context.Background()
+	config := storeConfig{Backend: "sqlite", DSN: filepath.Join(t.TempDir(), "store.db")}
+	first, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Lock(ctx); err != nil {
+		t.Fatal(err)
+	}
+	second, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	if err := second.Lock(ctx); err == nil {
+		t.Fatal("expected a second instance not to lock the store")
+	}
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Lock(ctx); err != nil {
+		t.Fatalf("expected the store to be locked once the first instance closed it: %v", err)
+	}
+
+	// The claim of an instance that stopped without closing the store expires.
+	third, err := newStore(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer third.Close()
+	stale := time.Now().Add(-instanceLease).UnixNano()
+	if _, err := second.(*sqlStore).db.Exec(`UPDATE instance_lock SET renewed_at = ?`, stale); err != nil {
+		t.Fatal(err)
+	}
+	if err := third.Lock(ctx); err != nil {
+		t.Fatalf("expected an expired claim to be taken over: %v", err)
+	}
+}
+
+func TestSearchRegistryRestoreRetention(t *testing.T) {
+	r := newSearchRegistry()
+	id := r.Store(nil)
+	now := time.Now()
+	r.Delete(id, now)
+	if _, ok := r.Restore(id, now.Add(deletedRetention)); ok {
+		t.Fatal("expected no restore past the retention")
+	}
+	if _, ok := r.Restore(id, now.Add(deletedRetention-time.Minute)); !ok {
+		t.Fatal("expected a restore within the retention")
+	}
+}
+
+func TestNewStoreInvalid(t *testing.T) {
+	for _, config := range []storeConfig{
+		{Backend: "redis"},
+		{Backend: "sqlite"},
+		{Backend: "sqlite", DSN: filepath.Join(t.TempDir(), "store.db"), WatchFile: "watches.json"},
+	} {
+		if _, err := newStore(config); err == nil {
+			t.Errorf("expected an error for %+v", config)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// structuredContentVersion is the first MCP protocol version with structured tool
+// results. Clients initializing an older version only read the text content.
+const structuredContentVersion = "2025-06-18"
+
+// textOnlyClient reports whether the client calling a tool only reads the text
+// content of the result, judging by the protocol version it initialized.
+func textOnlyClient(req *mcp.CallToolRequest) bool {
+	if req == nil || req.Session == nil {
+		return false
+	}
+	params := req.Session.InitializeParams()
+	// Protocol versions are dates, so they compare as strings.
+	return params != nil && params.ProtocolVersion < structuredContentVersion
+}
+
+// offersTable renders the offers of find_cheapest_offers as a table for text-only
+// clients. All offers are listed, as these clients can't read them anywhere else.
+func (s *server) offersTable(offers []offerResponse) string {
+	rows := make([][]string, 0, len(offers))
+	for _, o := range offers {
+		rows = append(rows, []string{
+			o.StartDate,
+			o.ReturnDate,
+			strconv.Itoa(o.TripLength),
+			o.SrcAirport + "-" + o.DstAirport,
+			s.formatPrice(o.Price) + " " + o.Currency,
+			o.ID,
+			o.ShareableLink,
+		})
+	}
+	return textTable([]string{"depart", "return", "days", "route", "price", "id", "link"}, rows)
+}
+
+// flightOffersTable renders the offers of search_flights as a table for text-only
+// clients, listing all of them like offersTable.
+func (s *server) flightOffersTable(offers []flightOfferResponse) string {
+	rows := make([][]string, 0, len(offers))
+	for _, o := range offers {
+		rows = append(rows, []string{
+			o.SrcAirport + "-" + o.DstAirport,
+			s.formatPrice(o.Price) + " " + o.Currency,
+			strings.Join(o.Airlines, ", "),
+			strings.Join(o.FlightNumbers, " / "),
+			strconv.Itoa(o.Stops),
+			fmt.Sprintf("%dh%02dm", o.TotalDurationMinutes/60, o.TotalDurationMinutes%60),
+			o.ID,
+			o.ShareableLink,
+		})
+	}
+	return textTable([]string{"route", "price", "airlines", "flights", "stops", "duration", "id", "link"}, rows)
+}
+
+// textTable renders rows as a table.
+func textTable(header []string, rows [][]string) string {
+	var b strings.Builder
+	writeTable(&b, header, rows)
+	return strings.TrimSuffix(b.String(), "\n")
+}
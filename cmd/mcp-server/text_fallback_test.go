@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// rawClient speaks JSON-RPC to the streamable HTTP endpoint directly, so that it
+// can initialize any protocol version.
+type rawClient struct {
+	t         *testing.T
+	url       string
+	version   string
+	sessionID string
+}
+
+// call posts a message and returns the result of its response, nil for a notification.
+func (c *rawClient) call(method string, id int, params any) json.RawMessage {
+	c.t.Helper()
+	message := map[string]any{"jsonrpc": "2.0", "method": method, "params": params}
+	if id > 0 {
+		message["id"] = id
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, c.url, strings.NewReader(string(body)))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if c.sessionID != "" {
+		req.Header.Set("Mcp-Session-Id", c.sessionID)
+		req.Header.Set("Mcp-Protocol-Version", c.version)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		c.t.Fatalf("%s: status %s", method, resp.Status)
+	}
+	if id := resp.Header.Get("Mcp-Session-Id"); id != "" {
+		c.sessionID = id
+	}
+	if id == 0 {
+		return nil
+	}
+	// The response is the data of the first event of the stream.
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var response struct {
+			Result json.RawMessage `json:"result"`
+			Error  any             `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(data), &response); err != nil {
+			c.t.Fatal(err)
+		}
+		if response.Error != nil {
+			c.t.Fatalf("%s: %v", method, response.Error)
+		}
+		return response.Result
+	}
+	c.t.Fatalf("%s: no response", method)
+	return nil
+}
+
+// findCheapestOffersText calls find_cheapest_offers as a client initializing
+// version and returns the text content of the result.
+func findCheapestOffersText(t *testing.T, s *server, version string) string {
+	srv := httptest.NewServer(s.mcpHandler(context.Background(), "streamable-http"))
+	defer srv.Close()
+
+	c := &rawClient{t: t, url: srv.URL, version: version}
+	c.call("initialize", 1, map[string]any{
+		"protocolVersion": version,
+		"capabilities":    map[string]any{},
+		"clientInfo":      map[string]any{"name": "test-client", "version": "0.0.1"},
+	})
+	c.call("notifications/initialized", 0, map[string]any{})
+	result := c.call("tools/call", 2, map[string]any{"name": "find_cheapest_offers", "arguments": findCheapestOffersArgs()})
+
+	var toolResult struct {
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+	}
+	if err := json.Unmarshal(result, &toolResult); err != nil {
+		t.Fatal(err)
+	}
+	if len(toolResult.Content) == 0 {
+		t.Fatalf("no content in %s", result)
+	}
+	return toolResult.Content[0].Text
+}
+
+func TestTextOnlyClient(t *testing.T) {
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			start := args.RangeStartDate.AddDate(0, 0, 1)
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, args.TripLength), Price: 100}}, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 80},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	})
+
+	text := findCheapestOffersText(t, s, "2025-03-26")
+	if !strings.Contains(text, "DEPART") || !strings.Contains(text, "SFO-JFK") || !strings.Contains(text, "https://") {
+		t.Fatalf("expected a table of the offers for a text-only client, got %q", text)
+	}
+
+	text = findCheapestOffersText(t, s, structuredContentVersion)
+	if strings.Contains(text, "DEPART") {
+		t.Fatalf("expected the short summary for a client reading structured content, got %q", text)
+	}
+}
+
+func TestOffersTableListsAll(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	offers := make([]offerResponse, 25)
+	for i := range offers {
+		offers[i] = offerResponse{StartDate: time.Now().Format(time.DateOnly), SrcAirport: "SFO", DstAirport: "JFK", Price: float64(100 + i), ID: "offer-" + strconv.Itoa(i)}
+	}
+	table := s.offersTable(offers)
+	if lines := strings.Count(table, "\n") + 1; lines != len(offers)+1 {
+		t.Fatalf("expected a header and %d offers, got %d lines:\n%s", len(offers), lines, table)
+	}
+	if !strings.Contains(table, "offer-24") || strings.Contains(table, "structured") {
+		t.Fatalf("expected every offer without pointing to the structured response, got:\n%s", table)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// checkTransport validates the value of the --transport flag.
+func checkTransport(transport string) error {
+	switch transport {
+	case "stdio", "sse", "streamable-http":
+		return nil
+	}
+	return fmt.Errorf("unknown transport %q, expected stdio, sse or streamable-http", transport)
+}
+
+// serveStdio serves a single MCP session over stdin and stdout until the client
+// closes stdin or ctx is done.
+func (s *server) serveStdio(ctx context.Context) error {
+	return s.newMCPServer(ctx).Run(ctx, &mcp.StdioTransport{})
+}
+
+// mcpHandler returns the HTTP handler of the MCP endpoint for an HTTP transport.
+// connCtx bounds the sessions that outlive a single request.
+func (s *server) mcpHandler(connCtx context.Context, transport string) http.Handler {
+	if transport == "streamable-http" {
+		// A streamable HTTP session spans many requests, so there's no request
+		// whose context could end it. One server serves all sessions until shutdown.
+		mcpServer := s.newMCPServer(connCtx)
+		return mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+			return mcpServer
+		}, nil)
+	}
+	// Every SSE session lives as long as its hanging GET request, whose context
+	// is canceled when the client disconnects.
+	return mcp.NewSSEHandler(func(r *http.Request) *mcp.Server {
+		return s.newMCPServer(r.Context())
+	}, nil)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestHTTPTransports(t *testing.T) {
+	for transport, clientTransport := range map[string]func(url string) mcp.Transport{
+		"sse":             func(url string) mcp.Transport { return &mcp.SSEClientTransport{Endpoint: url} },
+		"streamable-http": func(url string) mcp.Transport { return &mcp.StreamableClientTransport{Endpoint: url} },
+	} {
+		t.Run(transport, func(t *testing.T) {
+			if err := checkTransport(transport); err != nil {
+				t.Fatal(err)
+			}
+			srv := httptest.NewServer(newTestServer(t, &fakeSession{}).mcpHandler(context.Background(), transport))
+			defer srv.Close()
+
+			client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "0.0.1"}, nil)
+			clientSession, err := client.Connect(context.Background(), clientTransport(srv.URL), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer clientSession.Close()
+
+			result, err := clientSession.CallTool(context.Background(), &mcp.CallToolParams{Name: "server_info", Arguments: map[string]any{}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.IsError {
+				t.Fatalf("unexpected tool error: %+v", result.Content)
+			}
+		})
+	}
+
+	if err := checkTransport("websocket"); err == nil {
+		t.Fatal("expected an error for an unknown transport")
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type listTripsParams struct{}
+
+type tripParams struct {
+	Trip string `json:"trip" jsonschema:"Name of the trip, as given to create_price_watch"`
+}
+
+type tripResponse struct {
+	Name            string          `json:"name"`
+	Watches         []watchResponse `json:"watches"` // oldest first
+	Active          int             `json:"active"`  // watches that are checked
+	Paused          int             `json:"paused"`
+	Expired         int             `json:"expired"`
+	Events          int             `json:"events"`                    // recent events of the trip's watches
+	CheapestWatchID string          `json:"cheapestWatchId,omitempty"` // watch with the lowest last price
+	CheapestPrice   float64         `json:"cheapestPrice,omitempty"`
+	Currency        string          `json:"currency,omitempty"` // currency of cheapestPrice
+}
+
+type listTripsResponse struct {
+	SchemaVersion int            `json:"schemaVersion"`
+	Trips         []tripResponse `json:"trips"` // by name
+}
+
+type pauseTripResponse struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Trip          string `json:"trip"`
+	Watches       int    `json:"watches"`
+	Paused        bool   `json:"paused"`
+}
+
+func (s *server) listTrips(ctx context.Context, _ *mcp.CallToolRequest, _ listTripsParams) (*mcp.CallToolResult, listTripsResponse, error) {
+	watches, events := s.watches.list()
+	eventsByWatch := map[string]int{}
+	for _, e := range events {
+		eventsByWatch[e.WatchID]++
+	}
+
+	trips := map[string]*tripResponse{}
+	for _, w := range watches {
+		if w.Params.Trip == "" {
+			continue
+		}
+		trip, ok := trips[w.Params.Trip]
+		if !ok {
+			trip = &tripResponse{Name: w.Params.Trip}
+			trips[w.Params.Trip] = trip
+		}
+		watch := s.newWatchResponse(w)
+		trip.Watches = append(trip.Watches, watch)
+		trip.Events += eventsByWatch[w.ID]
+		switch {
+		case watch.Expired:
+			trip.Expired++
+		case watch.Paused:
+			trip.Paused++
+		default:
+			trip.Active++
+		}
+		if !watch.Expired && watch.LastPrice > 0 && (trip.CheapestPrice == 0 || watch.LastPrice < trip.CheapestPrice) {
+			trip.CheapestWatchID, trip.CheapestPrice, trip.Currency = watch.ID, watch.LastPrice, watch.Currency
+		}
+	}
+
+	response := listTripsResponse{SchemaVersion: s.schemaVersion, Trips: make([]tripResponse, 0, len(trips))}
+	for _, trip := range trips {
+		response.Trips = append(response.Trips, *trip)
+	}
+	sort.Slice(response.Trips, func(i, j int) bool { return response.Trips[i].Name < response.Trips[j].Name })
+
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("%d trip(s).", len(response.Trips)))
+	for _, trip := range response.Trips {
+		summary.WriteString(fmt.Sprintf("\n%s: %d active, %d paused, %d expired watch(es), %d recent event(s)",
+			trip.Name, trip.Active, trip.Paused, trip.Expired, trip.Events))
+		if trip.CheapestPrice > 0 {
+			summary.WriteString(fmt.Sprintf(", cheapest %s %s (watch %s)", s.formatPrice(trip.CheapestPrice), trip.Currency, trip.CheapestWatchID))
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+func (s *server) pauseTrip(ctx context.Context, _ *mcp.CallToolRequest, params tripParams) (*mcp.CallToolResult, pauseTripResponse, error) {
+	return s.setTripPaused(params, true)
+}
+
+func (s *server) resumeTrip(ctx context.Context, _ *mcp.CallToolRequest, params tripParams) (*mcp.CallToolResult, pauseTripResponse, error) {
+	return s.setTripPaused(params, false)
+}
+
+func (s *server) setTripPaused(params tripParams, paused bool) (*mcp.CallToolResult, pauseTripResponse, error) {
+	trip := strings.TrimSpace(params.Trip)
+	if trip == "" {
+		return nil, pauseTripResponse{}, fmt.Errorf("trip is required")
+	}
+	n, err := s.watches.setTripPaused(trip, paused)
+	if err != nil {
+		return nil, pauseTripResponse{}, fmt.Errorf("save watches: %w", err)
+	}
+	if n == 0 {
+		return nil, pauseTripResponse{}, fmt.Errorf("unknown trip %q, see list_trips", trip)
+	}
+
+	verb := "Resumed"
+	if paused {
+		verb = "Paused"
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%s %d watch(es) of trip %s.", verb, n, trip)},
+		},
+	}
+	return result, pauseTripResponse{SchemaVersion: s.schemaVersion, Trip: trip, Watches: n, Paused: paused}, nil
+}
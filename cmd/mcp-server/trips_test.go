@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestTrips(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 1)
+	checks := 0
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			checks++
+			price := 400.0
+			if args.DstCities[0] == "Boston" {
+				price = 300
+			}
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: price}}, nil
+		},
+	})
+	var ids []string
+	for _, dst := range []string{"New York", "Boston"} {
+		params := watchParams()
+		params.DstCities = []string{dst}
+		params.Trip = " East coast "
+		_, created, err := s.createPriceWatch(context.Background(), nil, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, created.Watch.ID)
+	}
+	if _, _, err := s.createPriceWatch(context.Background(), nil, watchParams()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, listed, err := s.listTrips(context.Background(), nil, listTripsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.Trips) != 1 {
+		t.Fatalf("expected one trip, got %+v", listed.Trips)
+	}
+	trip := listed.Trips[0]
+	if trip.Name != "East coast" || len(trip.Watches) != 2 || trip.Active != 2 || trip.CheapestWatchID != ids[1] || trip.CheapestPrice != 300 {
+		t.Fatalf("wrong trip: %+v", trip)
+	}
+
+	if _, paused, err := s.pauseTrip(context.Background(), nil, tripParams{Trip: "East coast"}); err != nil || paused.Watches != 2 {
+		t.Fatalf("expected 2 paused watches, got %+v, %v", paused, err)
+	}
+	checks = 0
+	s.checkWatches(context.Background())
+	if checks != 1 {
+		t.Fatalf("expected only the watch outside the trip to be checked, got %d check(s)", checks)
+	}
+	_, listed, _ = s.listTrips(context.Background(), nil, listTripsParams{})
+	if listed.Trips[0].Paused != 2 || listed.Trips[0].Active != 0 {
+		t.Fatalf("expected a paused trip, got %+v", listed.Trips[0])
+	}
+
+	if _, _, err := s.resumeTrip(context.Background(), nil, tripParams{Trip: "East coast"}); err != nil {
+		t.Fatal(err)
+	}
+	checks = 0
+	s.checkWatches(context.Background())
+	if checks != 3 {
+		t.Fatalf("expected every watch to be checked, got %d check(s)", checks)
+	}
+	if _, _, err := s.pauseTrip(context.Background(), nil, tripParams{Trip: "West coast"}); err == nil {
+		t.Fatal("expected an error for an unknown trip")
+	}
+}
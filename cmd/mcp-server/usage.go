@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// usageMeter counts the upstream searches (price graph and offer requests) issued
+// by the deployment, in total and per client of the callMeta of their context, and
+// enforces an optional daily quota. Days are UTC days. It schedules background
+// requests behind the interactive ones of clients: background requests can't use
+// the share of the quota interactiveReserve keeps for clients, and wait while
+// interactive requests are running, for at most backgroundWait each, so that
+// steady interactive load slows them down rather than starving them. It satisfies
+// cheapoffers.Session.
+type usageMeter struct {
+	session            cheapoffers.Session
+	dailyQuota         int     // 0 means unlimited
+	interactiveReserve float64 // share of dailyQuota background requests can't use
+	backgroundWait     time.Duration
+	now                func() time.Time
+
+	mu       sync.Mutex
+	day      time.Time // start of the day the counter belongs to
+	used     int
+	usedBy   map[string]int // used by callMeta.Client, at most maxTrackedClients of them
+	inFlight int
+
+	interactive int           // interactive requests in flight
+	idle        chan struct{} // closed when the last interactive request in flight is done
+}
+
+// defaultInteractiveReserve is the share of the daily quota kept for interactive
+// requests unless --interactive-reserve says otherwise.
+const defaultInteractiveReserve = 0.2
+
+// maxBackgroundWait is the longest a background request waits for the interactive
+// requests in flight.
+const maxBackgroundWait = 5 * time.Second
+
+func newUsageMeter(session cheapoffers.Session, dailyQuota int, interactiveReserve float64) *usageMeter {
+	return &usageMeter{
+		session:            session,
+		dailyQuota:         dailyQuota,
+		interactiveReserve: interactiveReserve,
+		backgroundWait:     maxBackgroundWait,
+		now:                time.Now,
+	}
+}
+
+// rollOver resets the counter at the start of a new day. m.mu must be held.
+func (m *usageMeter) rollOver() {
+	day := m.now().UTC().Truncate(24 * time.Hour)
+	if !day.Equal(m.day) {
+		m.day = day
+		m.used = 0
+		m.usedBy = map[string]int{}
+	}
+}
+
+// maxTrackedClients bounds the clients counted separately per day, further clients
+// are counted as otherClients. Client names come from the MCP clients, so without
+// a bound every new name would add a metric series.
+const maxTrackedClients = 50
+
+const otherClients = "other"
+
+func (m *usageMeter) acquire(ctx context.Context, meta callMeta) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if meta.Priority == priorityBackground {
+		if err := m.waitForInteractive(ctx); err != nil {
+			return err
+		}
+	}
+	m.rollOver()
+	if m.dailyQuota > 0 && m.used >= m.dailyQuota {
+		return fmt.Errorf("daily quota of %d upstream requests exhausted, it resets at %s",
+			m.dailyQuota, m.day.Add(24*time.Hour).Format(time.RFC3339))
+	}
+	if meta.Priority == priorityBackground && !m.backgroundAllowed() {
+		return fmt.Errorf("background requests paused, %.0f%% of the daily quota of %d upstream requests are used, it resets at %s",
+			(1-m.interactiveReserve)*100, m.dailyQuota, m.day.Add(24*time.Hour).Format(time.RFC3339))
+	}
+	m.used++
+	client := meta.Client
+	if _, ok := m.usedBy[client]; !ok && len(m.usedBy) >= maxTrackedClients {
+		client = otherClients
+	}
+	m.usedBy[client]++
+	m.inFlight++
+	if meta.Priority == priorityInteractive {
+		if m.interactive == 0 {
+			m.idle = make(chan struct{})
+		}
+		m.interactive++
+	}
+	return nil
+}
+
+// waitForInteractive waits until no interactive request is in flight, or for
+// m.backgroundWait if they keep coming. m.mu must be held, it is released while
+// waiting.
+func (m *usageMeter) waitForInteractive(ctx context.Context) error {
+	timeout := time.NewTimer(m.backgroundWait)
+	defer timeout.Stop()
+	for m.interactive > 0 {
+		idle := m.idle
+		m.mu.Unlock()
+		timedOut := false
+		select {
+		case <-idle:
+		case <-timeout.C:
+			timedOut = true
+		case <-ctx.Done():
+		}
+		m.mu.Lock()
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if timedOut {
+			return nil
+		}
+	}
+	return nil
+}
+
+// allowBackground reports whether a background request fits the daily quota.
+func (m *usageMeter) allowBackground() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rollOver()
+	return m.backgroundAllowed()
+}
+
+// backgroundAllowed is allowBackground with m.mu held.
+func (m *usageMeter) backgroundAllowed() bool {
+	return m.dailyQuota == 0 || float64(m.used) < float64(m.dailyQuota)*(1-m.interactiveReserve)
+}
+
+func (m *usageMeter) release(meta callMeta) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inFlight--
+	if meta.Priority == priorityInteractive {
+		m.interactive--
+		if m.interactive == 0 {
+			close(m.idle)
+		}
+	}
+}
+
+func (m *usageMeter) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	meta := callMetaFrom(ctx)
+	if err := m.acquire(ctx, meta); err != nil {
+		return nil, err
+	}
+	defer m.release(meta)
+	offers, err := m.session.GetPriceGraph(ctx, args)
+	logUpstreamError(ctx, meta, "price graph", err)
+	return offers, err
+}
+
+func (m *usageMeter) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	meta := callMetaFrom(ctx)
+	if err := m.acquire(ctx, meta); err != nil {
+		return nil, nil, err
+	}
+	defer m.release(meta)
+	offers, priceRange, err := m.session.GetOffers(ctx, args)
+	logUpstreamError(ctx, meta, "offers", err)
+	return offers, priceRange, err
+}
+
+// logUpstreamError logs a failed upstream request with the call it was made for.
+// Requests canceled by their caller aren't failures.
+func logUpstreamError(ctx context.Context, meta callMeta, request string, err error) {
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+	log.Printf("upstream %s request for %s (request %s, tool %q, %s) failed: %v",
+		request, meta.Client, meta.RequestID, meta.Tool, meta.Priority, err)
+}
+
+// SerializeURL isn't metered, it only resolves cities that are usually cached.
+func (m *usageMeter) SerializeURL(ctx context.Context, args flights.Args) (string, error) {
+	return m.session.SerializeURL(ctx, args)
+}
+
+type getUsageParams struct{}
+
+type getUsageResponse struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Used          int    `json:"used"`                // upstream requests issued today
+	DailyQuota    int    `json:"dailyQuota"`          // 0 if unlimited
+	Remaining     *int   `json:"remaining,omitempty"` // omitted if unlimited
+	ResetsAt      string `json:"resetsAt"`
+	InFlight      int    `json:"inFlight"` // upstream requests currently running
+}
+
+func (s *server) getUsage(_ context.Context, _ *mcp.CallToolRequest, _ getUsageParams) (*mcp.CallToolResult, getUsageResponse, error) {
+	m := s.usage
+	m.mu.Lock()
+	m.rollOver()
+	response := getUsageResponse{
+		SchemaVersion: s.schemaVersion,
+		Used:          m.used,
+		DailyQuota:    m.dailyQuota,
+		ResetsAt:      m.day.Add(24 * time.Hour).Format(time.RFC3339),
+		InFlight:      m.inFlight,
+	}
+	m.mu.Unlock()
+
+	text := fmt.Sprintf("%d upstream requests used today, no daily quota. %d running.", response.Used, response.InFlight)
+	if response.DailyQuota > 0 {
+		remaining := max(response.DailyQuota-response.Used, 0)
+		response.Remaining = &remaining
+		text = fmt.Sprintf("%d of %d upstream requests used today, %d remaining until %s. %d running.",
+			response.Used, response.DailyQuota, remaining, response.ResetsAt, response.InFlight)
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: text},
+		},
+	}
+	return result, response, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestUsageMeterQuota(t *testing.T) {
+	now := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	m := newUsageMeter(&fakeSession{}, 2, defaultInteractiveReserve)
+	m.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := m.GetPriceGraph(ctx, flights.PriceGraphArgs{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := m.GetOffers(ctx, flights.Args{}); err == nil {
+		t.Fatal("expected the quota to be exhausted")
+	}
+
+	// The quota resets at midnight UTC.
+	now = now.Add(2 * time.Hour)
+	if _, _, err := m.GetOffers(ctx, flights.Args{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUsageMeterAllowBackground(t *testing.T) {
+	m := newUsageMeter(&fakeSession{}, 5, defaultInteractiveReserve)
+	if !m.allowBackground() {
+		t.Fatal("expected background requests with a fresh quota")
+	}
+	for i := 0; i < 4; i++ {
+		if _, err := m.GetPriceGraph(context.Background(), flights.PriceGraphArgs{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if m.allowBackground() {
+		t.Fatal("expected the rest of the quota to be kept for clients")
+	}
+	if !newUsageMeter(&fakeSession{}, 0, defaultInteractiveReserve).allowBackground() {
+		t.Fatal("expected background requests without a quota")
+	}
+}
+
+func TestUsageMeterInteractiveReserve(t *testing.T) {
+	m := newUsageMeter(&fakeSession{}, 10, 0.5)
+	background := backgroundContext(context.Background(), "watches")
+	for i := 0; i < 5; i++ {
+		if _, err := m.GetPriceGraph(background, flights.PriceGraphArgs{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.GetPriceGraph(background, flights.PriceGraphArgs{}); err == nil {
+		t.Fatal("expected the reserved half of the quota to be kept for clients")
+	}
+	if _, err := m.GetPriceGraph(context.Background(), flights.PriceGraphArgs{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUsageMeterBackgroundWaitsForInteractive(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	m := newUsageMeter(&fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			if callMetaFrom(ctx).Priority == priorityInteractive {
+				close(started)
+				<-release
+			}
+			return nil, nil
+		},
+	}, 0, defaultInteractiveReserve)
+
+	go m.GetPriceGraph(context.Background(), flights.PriceGraphArgs{})
+	<-started
+
+	background := backgroundContext(context.Background(), "watches")
+	done := make(chan error)
+	go func() {
+		_, err := m.GetPriceGraph(background, flights.PriceGraphArgs{})
+		done <- err
+	}()
+	select {
+	case <-done:
+		t.Fatal("the background request ran while an interactive one was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// A background request gives up waiting when its context is done.
+	m = newUsageMeter(&fakeSession{}, 0, defaultInteractiveReserve)
+	if err := m.acquire(context.Background(), callMeta{Client: "test-client"}); err != nil {
+		t.Fatal(err)
+	}
+	canceled, cancel := context.WithCancel(background)
+	cancel()
+	if _, err := m.GetPriceGraph(canceled, flights.PriceGraphArgs{}); err == nil {
+		t.Fatal("expected the canceled background request to fail")
+	}
+}
+
+func TestUsageMeterBackgroundProgressesUnderInteractiveLoad(t *testing.T) {
+	m := newUsageMeter(&fakeSession{}, 0, defaultInteractiveReserve)
+	m.backgroundWait = 20 * time.Millisecond
+
+	// Clients keep an interactive request in flight the whole time.
+	client := callMeta{Client: "test-client", Priority: priorityInteractive}
+	if err := m.acquire(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	defer m.release(client)
+
+	background := backgroundContext(context.Background(), "watches")
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		if _, err := m.GetPriceGraph(background, flights.PriceGraphArgs{}); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed < m.backgroundWait || elapsed > time.Second {
+			t.Fatalf("expected the background request to wait %s for the interactive load, it took %s", m.backgroundWait, elapsed)
+		}
+	}
+}
+
+func TestGetUsage(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	s.usage.dailyQuota = 10
+	if _, err := s.usage.GetPriceGraph(context.Background(), flights.PriceGraphArgs{}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := connect(t, s).CallTool(context.Background(), &mcp.CallToolParams{Name: "get_usage", Arguments: map[string]any{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage := result.StructuredContent.(map[string]any)
+	if usage["used"] != float64(1) || usage["remaining"] != float64(9) || usage["inFlight"] != float64(0) {
+		t.Fatalf("wrong usage: %v", usage)
+	}
+}
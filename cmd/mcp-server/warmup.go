@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+)
+
+const (
+	warmUpStarting = "starting"
+	warmUpReady    = "ready"
+
+	warmUpMinBackoff = time.Second
+	warmUpMaxBackoff = time.Minute
+)
+
+var errWarmingUp = errors.New("the server is still warming up, try again shortly")
+
+// warmUp creates the Google Flights session in the background. Until a session
+// has been created and a probe query succeeded, it rejects all searches with
+// errWarmingUp. It satisfies cheapoffers.Session.
+type warmUp struct {
+	newSession func(ctx context.Context) (cheapoffers.Session, error)
+	probe      func(ctx context.Context, session cheapoffers.Session) error
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mu       sync.Mutex
+	session  cheapoffers.Session
+	attempts int
+	lastErr  error
+	readyAt  time.Time
+}
+
+func newWarmUp(
+	newSession func(ctx context.Context) (cheapoffers.Session, error),
+	probe func(ctx context.Context, session cheapoffers.Session) error,
+) *warmUp {
+	return &warmUp{
+		newSession: newSession,
+		probe:      probe,
+		minBackoff: warmUpMinBackoff,
+		maxBackoff: warmUpMaxBackoff,
+	}
+}
+
+// Run retries creating and probing a session with exponential backoff until it
+// succeeds or ctx is done.
+func (w *warmUp) Run(ctx context.Context) error {
+	backoff := w.minBackoff
+	for {
+		err := w.attempt(ctx)
+		if err == nil {
+			return nil
+		}
+
+		w.mu.Lock()
+		w.attempts++
+		w.lastErr = err
+		attempts := w.attempts
+		w.mu.Unlock()
+		log.Printf("warm-up attempt %d failed, retrying in %s: %v", attempts, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, w.maxBackoff)
+	}
+}
+
+func (w *warmUp) attempt(ctx context.Context) error {
+	session, err := w.newSession(ctx)
+	if err != nil {
+		return err
+	}
+	if err := w.probe(ctx, session); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.attempts++
+	w.lastErr = nil
+	w.session = session
+	w.readyAt = time.Now()
+	return nil
+}
+
+func (w *warmUp) current() (cheapoffers.Session, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.session == nil {
+		return nil, errWarmingUp
+	}
+	return w.session, nil
+}
+
+func (w *warmUp) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	session, err := w.current()
+	if err != nil {
+		return nil, err
+	}
+	return session.GetPriceGraph(ctx, args)
+}
+
+func (w *warmUp) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	session, err := w.current()
+	if err != nil {
+		return nil, nil, err
+	}
+	return session.GetOffers(ctx, args)
+}
+
+func (w *warmUp) SerializeURL(ctx context.Context, args flights.Args) (string, error) {
+	session, err := w.current()
+	if err != nil {
+		return "", err
+	}
+	return session.SerializeURL(ctx, args)
+}
+
+type readinessResponse struct {
+	Status     string `json:"status"`
+	Attempts   int    `json:"attempts"`
+	LastError  string `json:"lastError,omitempty"`
+	ReadySince string `json:"readySince,omitempty"`
+}
+
+func (w *warmUp) status() readinessResponse {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	response := readinessResponse{
+		Status:   warmUpStarting,
+		Attempts: w.attempts,
+	}
+	if w.lastErr != nil {
+		response.LastError = w.lastErr.Error()
+	}
+	if w.session != nil {
+		response.Status = warmUpReady
+		response.ReadySince = w.readyAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+// ServeHTTP reports the warm-up status. It responds with 503 Service Unavailable
+// until the server is ready to serve searches.
+func (w *warmUp) ServeHTTP(rw http.ResponseWriter, _ *http.Request) {
+	response := w.status()
+	rw.Header().Set("Content-Type", "application/json")
+	if response.Status != warmUpReady {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(rw).Encode(response)
+}
+
+// probeSearch runs a single price graph query, which exercises the city lookup
+// and the flights endpoint without fanning out into offer searches.
+func probeSearch(ctx context.Context, session cheapoffers.Session) error {
+	start := time.Now().AddDate(0, 1, 0)
+	_, err := session.GetPriceGraph(ctx, flights.PriceGraphArgs{
+		RangeStartDate: start,
+		RangeEndDate:   start.AddDate(0, 0, 7),
+		TripLength:     7,
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+		Options:        flights.OptionsDefault(),
+	})
+	return err
+}
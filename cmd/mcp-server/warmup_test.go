@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/internal/cheapoffers"
+)
+
+func readiness(t *testing.T, w *warmUp) (int, readinessResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	w.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var response readinessResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	return rec.Code, response
+}
+
+func TestWarmUpRetries(t *testing.T) {
+	newSessionCalls := 0
+	probeCalls := 0
+	session := &fakeSession{}
+
+	w := newWarmUp(
+		func(context.Context) (cheapoffers.Session, error) {
+			newSessionCalls++
+			if newSessionCalls == 1 {
+				return nil, errors.New("connection reset")
+			}
+			return session, nil
+		},
+		func(context.Context, cheapoffers.Session) error {
+			probeCalls++
+			if probeCalls == 1 {
+				return errors.New("unexpected status code")
+			}
+			return nil
+		},
+	)
+	w.minBackoff = time.Millisecond
+	w.maxBackoff = time.Millisecond
+
+	if _, err := w.GetPriceGraph(context.Background(), flights.PriceGraphArgs{}); !errors.Is(err, errWarmingUp) {
+		t.Fatalf("expected errWarmingUp before warm-up, got: %v", err)
+	}
+	if code, response := readiness(t, w); code != http.StatusServiceUnavailable || response.Status != warmUpStarting {
+		t.Fatalf("unexpected readiness before warm-up: %d %+v", code, response)
+	}
+
+	if err := w.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	code, response := readiness(t, w)
+	if code != http.StatusOK || response.Status != warmUpReady {
+		t.Fatalf("unexpected readiness after warm-up: %d %+v", code, response)
+	}
+	if response.Attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", response.Attempts)
+	}
+	if _, err := w.GetPriceGraph(context.Background(), flights.PriceGraphArgs{}); err != nil {
+		t.Fatalf("unexpected error after warm-up: %v", err)
+	}
+}
+
+func TestWarmUpCanceled(t *testing.T) {
+	w := newWarmUp(
+		func(context.Context) (cheapoffers.Session, error) {
+			return nil, errors.New("connection reset")
+		},
+		probeSearch,
+	)
+	w.minBackoff = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := w.Run(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	code, response := readiness(t, w)
+	if code != http.StatusServiceUnavailable || response.LastError != "connection reset" {
+		t.Fatalf("unexpected readiness: %d %+v", code, response)
+	}
+}
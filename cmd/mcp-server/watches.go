@@ -0,0 +1,934 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxPriceWatches bounds the number of watches, every watch costs upstream requests
+// on every poll.
+const maxPriceWatches = 50
+
+// maxWatchEvents bounds the number of price drop events remembered.
+const maxWatchEvents = 100
+
+// watchEventsURI is the resource listing the recent price drop events. Subscribed
+// clients are notified when it changes.
+const watchEventsURI = "watches://events"
+
+// defaultBaselineDays is the price history a dropPercent watch compares with unless
+// baselineDays says otherwise.
+const defaultBaselineDays = 30
+
+// expiredWatchRetention is how long expired watches stay listed before they're
+// deleted.
+const expiredWatchRetention = 7 * 24 * time.Hour
+
+// Kinds of watch events.
+const (
+	watchEventPriceDrop = "price_drop" // the price dropped to or below the target
+	watchEventExpired   = "expired"    // the watch expired, with a summary of its checks
+)
+
+// maxOneWayWatchDays is the longest travel window of a one-way watch. The price
+// graph only covers round trips, so every check searches each departure date.
+const maxOneWayWatchDays = 14
+
+// minBaselineChecks is the number of checks a dropPercent watch needs before its
+// median tells a drop apart from the price of a single check.
+const minBaselineChecks = 4
+
+type createPriceWatchParams struct {
+	RangeStartDate string   `json:"rangeStartDate" jsonschema:"Earliest departure date to watch (YYYY-MM-DD)"`
+	RangeEndDate   string   `json:"rangeEndDate" jsonschema:"Last departure date to watch (YYYY-MM-DD)"`
+	TripLengths    []int    `json:"tripLengths,omitempty" jsonschema:"Trip lengths in days (e.g. [5,6]), required unless tripType is one-way"`
+	SrcCities      []string `json:"srcCities" jsonschema:"City names accepted by Google Flights"`
+	DstCities      []string `json:"dstCities" jsonschema:"Destination city names accepted by Google Flights"`
+	Language       string   `json:"language,omitempty" jsonschema:"Optional BCP 47 language tag, defaults to en"`
+	Currency       string   `json:"currency,omitempty" jsonschema:"Optional ISO 4217 currency code, defaults to USD"`
+	searchOptionsParams
+
+	MaxPrice     float64 `json:"maxPrice,omitempty" jsonschema:"Optional target price: an event is raised when the cheapest price of the window drops to or below it. For one-way watches it's the price of the single leg"`
+	DropPercent  float64 `json:"dropPercent,omitempty" jsonschema:"Optional, an event is raised when the cheapest price drops at least this many percent below the median of the watch's checks in the last baselineDays. At least one of maxPrice and dropPercent is required"`
+	BaselineDays int     `json:"baselineDays,omitempty" jsonschema:"Optional number of days of checks the median of dropPercent covers, defaults to 30"`
+	ExpiresAt    string  `json:"expiresAt,omitempty" jsonschema:"Optional last day the watch is checked (YYYY-MM-DD), defaults to rangeEndDate"`
+
+	Trip string `json:"trip,omitempty" jsonschema:"Optional name of the trip the watch belongs to. The watches of a trip are listed, paused and resumed together, see list_trips"`
+}
+
+type deletePriceWatchParams struct {
+	WatchID string `json:"watchId" jsonschema:"ID of the watch, as returned by create_price_watch"`
+}
+
+type restoreWatchParams struct {
+	WatchID string `json:"watchId" jsonschema:"ID of the deleted watch"`
+}
+
+type listPriceWatchesParams struct{}
+
+// priceWatch is a watch as persisted.
+type priceWatch struct {
+	ID        string                 `json:"id"`
+	Params    createPriceWatchParams `json:"params"`
+	CreatedAt time.Time              `json:"createdAt"`
+
+	LastCheckedAt time.Time `json:"lastCheckedAt"` // zero until the first check
+	LastPrice     float64   `json:"lastPrice"`     // cheapest price of the last successful check, 0 if none
+	LastError     string    `json:"lastError,omitempty"`
+
+	LowestPrice float64   `json:"lowestPrice,omitempty"` // cheapest price of all checks
+	Alerts      int       `json:"alerts,omitempty"`      // number of price drop events
+	ExpiredAt   time.Time `json:"expiredAt"`             // zero while the watch is active
+	Paused      bool      `json:"paused,omitempty"`      // paused watches aren't checked, but expire
+
+	// TargetPrice is the price at or below which the last check raised an event: the
+	// higher of maxPrice and the dropPercent price below the median, 0 if neither is
+	// known yet.
+	TargetPrice float64 `json:"targetPrice,omitempty"`
+
+	// AlertedPrice is the price of the last event. It's reset when the price rises
+	// above the target again, so that the next drop raises an event again.
+	AlertedPrice float64 `json:"alertedPrice,omitempty"`
+
+	// History holds the prices of the successful checks in the last baselineDays,
+	// oldest first. Only dropPercent watches keep it.
+	History []pricePoint `json:"history,omitempty"`
+}
+
+// deletedWatch is a watch deleted by delete_price_watch, which restore_watch can
+// restore for deletedRetention.
+type deletedWatch struct {
+	Watch     priceWatch `json:"watch"`
+	DeletedAt time.Time  `json:"deletedAt"`
+}
+
+type pricePoint struct {
+	At    time.Time `json:"at"`
+	Price float64   `json:"price"`
+}
+
+// watchEvent is a price drop of a watch to or below its target, or the expiry of a
+// watch.
+type watchEvent struct {
+	Kind          string  `json:"kind"` // price_drop or expired, see watchEventPriceDrop
+	WatchID       string  `json:"watchId"`
+	At            string  `json:"at"`
+	Route         string  `json:"route"`
+	Price         float64 `json:"price"`
+	MaxPrice      float64 `json:"maxPrice"`
+	DropPercent   float64 `json:"dropPercent,omitempty"`
+	Median        float64 `json:"median,omitempty"` // median of the baseline, set for dropPercent watches
+	TargetPrice   float64 `json:"targetPrice"`
+	Currency      string  `json:"currency"`
+	StartDate     string  `json:"startDate"`
+	ReturnDate    string  `json:"returnDate,omitempty"` // omitted for one-way watches
+	TripLength    int     `json:"tripLength,omitempty"` // omitted for one-way watches
+	ShareableLink string  `json:"shareableLink"`
+	LowestPrice   float64 `json:"lowestPrice,omitempty"` // set for expired events
+	Alerts        int     `json:"alerts,omitempty"`      // set for expired events
+}
+
+type watchResponse struct {
+	ID             string   `json:"id"`
+	Trip           string   `json:"trip,omitempty"`
+	Route          string   `json:"route"`
+	RangeStartDate string   `json:"rangeStartDate"`
+	RangeEndDate   string   `json:"rangeEndDate"`
+	TripLengths    []int    `json:"tripLengths"`
+	OneWay         bool     `json:"oneWay,omitempty"` // prices are of a single leg
+	MaxPrice       float64  `json:"maxPrice"`
+	DropPercent    float64  `json:"dropPercent,omitempty"`
+	BaselineDays   int      `json:"baselineDays,omitempty"`
+	Median         float64  `json:"median,omitempty"`      // median of the baseline, 0 until there are enough checks
+	TargetPrice    float64  `json:"targetPrice,omitempty"` // target of the last check
+	Currency       string   `json:"currency"`
+	CreatedAt      string   `json:"createdAt"`
+	ExpiresAt      string   `json:"expiresAt"`
+	Expired        bool     `json:"expired"` // expired watches aren't checked anymore and are deleted after 7 days
+	Paused         bool     `json:"paused"`
+	LowestPrice    float64  `json:"lowestPrice,omitempty"`
+	LastCheckedAt  string   `json:"lastCheckedAt,omitempty"`
+	LastPrice      float64  `json:"lastPrice,omitempty"`
+	LastError      string   `json:"lastError,omitempty"`
+	BelowTarget    bool     `json:"belowTarget"` // the last checked price is at or below targetPrice
+	SrcCities      []string `json:"srcCities"`
+	DstCities      []string `json:"dstCities"`
+}
+
+type createPriceWatchResponse struct {
+	SchemaVersion int           `json:"schemaVersion"`
+	Watch         watchResponse `json:"watch"`
+	Event         *watchEvent   `json:"event,omitempty"` // set if the price is already at or below the target
+}
+
+type listPriceWatchesResponse struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	Watches       []watchResponse `json:"watches"`
+	Events        []watchEvent    `json:"events"` // recent price drop events, newest first
+}
+
+type deletePriceWatchResponse struct {
+	SchemaVersion   int    `json:"schemaVersion"`
+	WatchID         string `json:"watchId"`
+	RestorableUntil string `json:"restorableUntil"` // restore_watch restores the watch until then
+}
+
+type restoreWatchResponse struct {
+	SchemaVersion int           `json:"schemaVersion"`
+	Watch         watchResponse `json:"watch"`
+}
+
+// watchList holds the price watches and their events. Every change is saved to the
+// store, so that restarts don't lose the watches if it's persistent.
+type watchList struct {
+	store watchStore
+
+	mu        sync.Mutex
+	watches   []priceWatch   // oldest first
+	deleted   []deletedWatch // oldest deletion first
+	events    []watchEvent   // oldest first
+	listeners map[*mcp.Server]bool
+}
+
+type watchListFile struct {
+	Watches []priceWatch   `json:"watches"`
+	Deleted []deletedWatch `json:"deleted,omitempty"`
+	Events  []watchEvent   `json:"events"`
+}
+
+// loadWatchList loads the watches saved to the store.
+func loadWatchList(ctx context.Context, st watchStore) (*watchList, error) {
+	file, err := st.LoadWatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &watchList{
+		store:     st,
+		watches:   file.Watches,
+		deleted:   file.Deleted,
+		events:    file.Events,
+		listeners: map[*mcp.Server]bool{},
+	}, nil
+}
+
+// saveLocked saves the watches to the store.
+func (l *watchList) saveLocked() error {
+	return l.store.SaveWatches(context.Background(), watchListFile{Watches: l.watches, Deleted: l.deleted, Events: l.events})
+}
+
+func (l *watchList) add(w priceWatch) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.watches) >= maxPriceWatches {
+		return fmt.Errorf("there are already %d watches, delete one first", maxPriceWatches)
+	}
+	l.watches = append(l.watches, w)
+	if err := l.saveLocked(); err != nil {
+		// The watch isn't kept unless it's saved, so that the caller's error stands.
+		l.watches = l.watches[:len(l.watches)-1]
+		return err
+	}
+	return nil
+}
+
+// remove deletes a watch for good, ok is false if it's unknown.
+func (l *watchList) remove(id string) (ok bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := slices.IndexFunc(l.watches, func(w priceWatch) bool { return w.ID == id })
+	if i < 0 {
+		return false, nil
+	}
+	l.watches = slices.Delete(l.watches, i, i+1)
+	return true, l.saveLocked()
+}
+
+// softDelete moves a watch to the deleted watches, which restore can undo. ok is
+// false if it's unknown.
+func (l *watchList) softDelete(id string, now time.Time) (ok bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := slices.IndexFunc(l.watches, func(w priceWatch) bool { return w.ID == id })
+	if i < 0 {
+		return false, nil
+	}
+	l.deleted = append(l.deleted, deletedWatch{Watch: l.watches[i], DeletedAt: now})
+	l.watches = slices.Delete(l.watches, i, i+1)
+	return true, l.saveLocked()
+}
+
+// restore moves a watch deleted less than deletedRetention ago back to the watches.
+// ok is false if there's no such watch.
+func (l *watchList) restore(id string, now time.Time) (w priceWatch, ok bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := slices.IndexFunc(l.deleted, func(d deletedWatch) bool {
+		return d.Watch.ID == id && now.Sub(d.DeletedAt) < deletedRetention
+	})
+	if i < 0 {
+		return priceWatch{}, false, nil
+	}
+	if len(l.watches) >= maxPriceWatches {
+		return priceWatch{}, false, fmt.Errorf("there are already %d watches, delete one first", maxPriceWatches)
+	}
+	w = l.deleted[i].Watch
+	l.deleted = slices.Delete(l.deleted, i, i+1)
+	l.watches = append(l.watches, w)
+	return w, true, l.saveLocked()
+}
+
+// purgeDeleted drops the watches deleted at least deletedRetention ago.
+func (l *watchList) purgeDeleted(now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n := len(l.deleted)
+	l.deleted = slices.DeleteFunc(l.deleted, func(d deletedWatch) bool {
+		return now.Sub(d.DeletedAt) >= deletedRetention
+	})
+	if len(l.deleted) == n {
+		return nil
+	}
+	return l.saveLocked()
+}
+
+// setTripPaused pauses or resumes the watches of a trip and returns how many
+// watches the trip has.
+func (l *watchList) setTripPaused(trip string, paused bool) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n := 0
+	for i := range l.watches {
+		if l.watches[i].Params.Trip == trip {
+			l.watches[i].Paused = paused
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return n, l.saveLocked()
+}
+
+// list returns copies of the watches, oldest first, and of the events, newest first.
+func (l *watchList) list() ([]priceWatch, []watchEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	events := slices.Clone(l.events)
+	slices.Reverse(events)
+	return slices.Clone(l.watches), events
+}
+
+// update records the outcome of a check or the expiry of the watch with the given
+// ID and adds event, if not nil. Only the fields set by checkWatch and expireWatch
+// are taken from checked, so that changes made during the check, e.g. pausing the
+// watch, are kept. Watches deleted during the check are ignored.
+func (l *watchList) update(checked priceWatch, event *watchEvent) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i := slices.IndexFunc(l.watches, func(w priceWatch) bool { return w.ID == checked.ID })
+	if i < 0 {
+		return nil
+	}
+	w := &l.watches[i]
+	w.LastCheckedAt, w.LastPrice, w.LastError = checked.LastCheckedAt, checked.LastPrice, checked.LastError
+	w.LowestPrice, w.TargetPrice, w.AlertedPrice = checked.LowestPrice, checked.TargetPrice, checked.AlertedPrice
+	w.Alerts, w.History, w.ExpiredAt = checked.Alerts, checked.History, checked.ExpiredAt
+	if event != nil {
+		l.events = append(l.events, *event)
+		if len(l.events) > maxWatchEvents {
+			l.events = l.events[len(l.events)-maxWatchEvents:]
+		}
+	}
+	return l.saveLocked()
+}
+
+// listen tells the clients of mcpServer about new events until ctx is done.
+func (l *watchList) listen(ctx context.Context, mcpServer *mcp.Server) {
+	l.mu.Lock()
+	l.listeners[mcpServer] = true
+	l.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		l.mu.Lock()
+		delete(l.listeners, mcpServer)
+		l.mu.Unlock()
+	}()
+}
+
+// notifyListeners tells the clients subscribed to watchEventsURI that it changed.
+func (l *watchList) notifyListeners(ctx context.Context) {
+	l.mu.Lock()
+	servers := make([]*mcp.Server, 0, len(l.listeners))
+	for srv := range l.listeners {
+		servers = append(servers, srv)
+	}
+	l.mu.Unlock()
+
+	for _, srv := range servers {
+		if err := srv.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: watchEventsURI}); err != nil {
+			log.Printf("notify watch event: %v", err)
+		}
+	}
+}
+
+func (s *server) createPriceWatch(ctx context.Context, _ *mcp.CallToolRequest, params createPriceWatchParams) (*mcp.CallToolResult, createPriceWatchResponse, error) {
+	if params.MaxPrice < 0 {
+		return nil, createPriceWatchResponse{}, fmt.Errorf("maxPrice must not be negative")
+	}
+	if params.DropPercent < 0 || params.DropPercent >= 100 {
+		return nil, createPriceWatchResponse{}, fmt.Errorf("dropPercent must be between 0 and 100")
+	}
+	if params.MaxPrice == 0 && params.DropPercent == 0 {
+		return nil, createPriceWatchResponse{}, fmt.Errorf("maxPrice or dropPercent is required")
+	}
+	if params.BaselineDays < 0 {
+		return nil, createPriceWatchResponse{}, fmt.Errorf("baselineDays must not be negative")
+	}
+	if params.DropPercent > 0 && params.BaselineDays == 0 {
+		params.BaselineDays = defaultBaselineDays
+	}
+	params.Trip = strings.TrimSpace(params.Trip)
+	if params.ExpiresAt != "" {
+		expiresAt, err := parseDate("expiresAt", params.ExpiresAt)
+		if err != nil {
+			return nil, createPriceWatchResponse{}, err
+		}
+		if expiresAt.Before(time.Now().UTC().Truncate(24 * time.Hour)) {
+			return nil, createPriceWatchResponse{}, fmt.Errorf("expiresAt must not be in the past")
+		}
+	}
+	if _, _, err := s.parseWatch(params, time.Now()); err != nil {
+		return nil, createPriceWatchResponse{}, err
+	}
+
+	w := priceWatch{ID: newSearchID(), Params: params, CreatedAt: time.Now().UTC()}
+	if err := s.watches.add(w); err != nil {
+		return nil, createPriceWatchResponse{}, fmt.Errorf("save watch: %w", err)
+	}
+	// The first check tells the client the current price right away.
+	w, event := s.checkWatch(ctx, w)
+	if err := s.watches.update(w, event); err != nil {
+		return nil, createPriceWatchResponse{}, fmt.Errorf("save watch: %w", err)
+	}
+	if event != nil {
+		s.watches.notifyListeners(ctx)
+	}
+
+	response := createPriceWatchResponse{SchemaVersion: s.schemaVersion, Watch: s.newWatchResponse(w), Event: event}
+
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("Watching %s %s, watch ID %s.",
+		response.Watch.Route, s.watchTarget(response.Watch), w.ID))
+	switch {
+	case w.LastError != "":
+		summary.WriteString(" The first check failed: " + w.LastError)
+	case event != nil:
+		summary.WriteString(fmt.Sprintf(" The price is already %s on %s.", s.formatPrice(event.Price), event.StartDate))
+	case w.LastPrice > 0:
+		summary.WriteString(fmt.Sprintf(" The cheapest price is currently %s.", s.formatPrice(w.LastPrice)))
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+func (s *server) listPriceWatches(ctx context.Context, _ *mcp.CallToolRequest, _ listPriceWatchesParams) (*mcp.CallToolResult, listPriceWatchesResponse, error) {
+	watches, events := s.watches.list()
+	response := listPriceWatchesResponse{
+		SchemaVersion: s.schemaVersion,
+		Watches:       make([]watchResponse, 0, len(watches)),
+		Events:        events,
+	}
+
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("%d watch(es), %d recent event(s).", len(watches), len(events)))
+	for _, w := range watches {
+		watch := s.newWatchResponse(w)
+		response.Watches = append(response.Watches, watch)
+		summary.WriteString(fmt.Sprintf("\n%s: %s %s", watch.ID, watch.Route, s.watchTarget(watch)))
+		if watch.Expired {
+			summary.WriteString(fmt.Sprintf(", expired, lowest price %s", s.formatPrice(watch.LowestPrice)))
+			continue
+		}
+		if watch.Paused {
+			summary.WriteString(", paused")
+		}
+		if watch.LastPrice > 0 {
+			summary.WriteString(fmt.Sprintf(", last price %s", s.formatPrice(watch.LastPrice)))
+		}
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: summary.String()},
+		},
+	}
+	return result, response, nil
+}
+
+func (s *server) deletePriceWatch(ctx context.Context, _ *mcp.CallToolRequest, params deletePriceWatchParams) (*mcp.CallToolResult, deletePriceWatchResponse, error) {
+	now := time.Now().UTC()
+	ok, err := s.watches.softDelete(params.WatchID, now)
+	if err != nil {
+		return nil, deletePriceWatchResponse{}, fmt.Errorf("save watches: %w", err)
+	}
+	if !ok {
+		return nil, deletePriceWatchResponse{}, fmt.Errorf("unknown watchId %q", params.WatchID)
+	}
+
+	response := deletePriceWatchResponse{
+		SchemaVersion:   s.schemaVersion,
+		WatchID:         params.WatchID,
+		RestorableUntil: now.Add(deletedRetention).Format(time.RFC3339),
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Deleted watch %s. restore_watch restores it until %s.", params.WatchID, response.RestorableUntil)},
+		},
+	}
+	return result, response, nil
+}
+
+func (s *server) restoreWatch(ctx context.Context, _ *mcp.CallToolRequest, params restoreWatchParams) (*mcp.CallToolResult, restoreWatchResponse, error) {
+	w, ok, err := s.watches.restore(params.WatchID, time.Now().UTC())
+	if err != nil {
+		return nil, restoreWatchResponse{}, fmt.Errorf("restore watch: %w", err)
+	}
+	if !ok {
+		return nil, restoreWatchResponse{}, fmt.Errorf("no deleted watch %q, it may have been deleted more than %d days ago", params.WatchID, int(deletedRetention.Hours()/24))
+	}
+
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("Restored watch %s.", w.ID)},
+		},
+	}
+	return result, restoreWatchResponse{SchemaVersion: s.schemaVersion, Watch: s.newWatchResponse(w)}, nil
+}
+
+// readWatchEvents serves watchEventsURI.
+func (s *server) readWatchEvents(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	_, events := s.watches.list()
+	data, err := json.Marshal(events)
+	if err != nil {
+		return nil, err
+	}
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{{URI: watchEventsURI, MIMEType: "application/json", Text: string(data)}},
+	}, nil
+}
+
+func (s *server) newWatchResponse(w priceWatch) watchResponse {
+	response := watchResponse{
+		ID:             w.ID,
+		Trip:           w.Params.Trip,
+		Route:          watchRoute(w.Params),
+		RangeStartDate: w.Params.RangeStartDate,
+		RangeEndDate:   w.Params.RangeEndDate,
+		TripLengths:    w.Params.TripLengths,
+		OneWay:         w.oneWay(),
+		MaxPrice:       s.roundPrice(w.Params.MaxPrice),
+		DropPercent:    w.Params.DropPercent,
+		BaselineDays:   w.Params.BaselineDays,
+		Median:         s.roundPrice(w.median()),
+		TargetPrice:    s.roundPrice(w.TargetPrice),
+		Currency:       strings.ToUpper(w.Params.Currency),
+		CreatedAt:      w.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:      w.expiresAt(),
+		Expired:        !w.ExpiredAt.IsZero(),
+		Paused:         w.Paused,
+		LowestPrice:    s.roundPrice(w.LowestPrice),
+		LastPrice:      s.roundPrice(w.LastPrice),
+		LastError:      w.LastError,
+		BelowTarget:    w.LastPrice > 0 && w.LastPrice <= w.TargetPrice,
+		SrcCities:      w.Params.SrcCities,
+		DstCities:      w.Params.DstCities,
+	}
+	if response.Currency == "" {
+		response.Currency = "USD"
+	}
+	if !w.LastCheckedAt.IsZero() {
+		response.LastCheckedAt = w.LastCheckedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+// watchTarget describes when a watch raises an event.
+func (s *server) watchTarget(w watchResponse) string {
+	var targets []string
+	if w.MaxPrice > 0 {
+		targets = append(targets, fmt.Sprintf("below %s %s", s.formatPrice(w.MaxPrice), w.Currency))
+	}
+	if w.DropPercent > 0 {
+		targets = append(targets, fmt.Sprintf("%s%% below the %d-day median", strconv.FormatFloat(w.DropPercent, 'f', -1, 64), w.BaselineDays))
+	}
+	return strings.Join(targets, " or ")
+}
+
+// median returns the median price of the watch's history, 0 if there are fewer than
+// minBaselineChecks checks.
+func (w priceWatch) median() float64 {
+	if len(w.History) < minBaselineChecks {
+		return 0
+	}
+	prices := make([]float64, 0, len(w.History))
+	for _, p := range w.History {
+		prices = append(prices, p.Price)
+	}
+	sort.Float64s(prices)
+	if n := len(prices); n%2 == 0 {
+		return (prices[n/2-1] + prices[n/2]) / 2
+	}
+	return prices[len(prices)/2]
+}
+
+// targetPrice returns the price at or below which a check raises an event, and the
+// median it's based on, if any.
+func (w priceWatch) targetPrice() (target, median float64) {
+	target = w.Params.MaxPrice
+	if w.Params.DropPercent > 0 {
+		median = w.median()
+		target = max(target, median*(1-w.Params.DropPercent/100))
+	}
+	return target, median
+}
+
+// recordPrice adds the price of a check to the history of a dropPercent watch and
+// forgets the checks older than its baseline.
+func (w *priceWatch) recordPrice(at time.Time, price float64) {
+	if w.Params.DropPercent == 0 {
+		return
+	}
+	since := at.AddDate(0, 0, -w.Params.BaselineDays)
+	w.History = slices.DeleteFunc(w.History, func(p pricePoint) bool { return p.At.Before(since) })
+	w.History = append(w.History, pricePoint{At: at, Price: price})
+}
+
+// expiresAt returns the last day the watch is checked.
+func (w priceWatch) expiresAt() string {
+	if w.Params.ExpiresAt != "" {
+		return w.Params.ExpiresAt
+	}
+	return w.Params.RangeEndDate
+}
+
+// expired reports whether the last day of the watch is over as of now.
+func (w priceWatch) expired(now time.Time) bool {
+	last, err := time.Parse(time.DateOnly, w.expiresAt())
+	if err != nil {
+		return false
+	}
+	return !now.Before(last.AddDate(0, 0, 1))
+}
+
+// expireWatch deactivates a watch and returns the event summarizing its checks.
+func (s *server) expireWatch(w priceWatch, now time.Time) (priceWatch, *watchEvent) {
+	w.ExpiredAt = now
+	currency := strings.ToUpper(w.Params.Currency)
+	if currency == "" {
+		currency = "USD"
+	}
+	return w, &watchEvent{
+		Kind:        watchEventExpired,
+		WatchID:     w.ID,
+		At:          now.Format(time.RFC3339),
+		Route:       watchRoute(w.Params),
+		MaxPrice:    s.roundPrice(w.Params.MaxPrice),
+		DropPercent: w.Params.DropPercent,
+		Currency:    currency,
+		LowestPrice: s.roundPrice(w.LowestPrice),
+		Alerts:      w.Alerts,
+	}
+}
+
+// watchRoute names the route of a watch in its language.
+func watchRoute(params createPriceWatchParams) string {
+	// The language was validated when the watch was created.
+	lang, _ := parseLanguage(params.Language)
+	route := routeName(params.SrcCities, nil, params.DstCities, nil, lang)
+	if params.TripType == "one-way" {
+		route += " (one-way)"
+	}
+	return route
+}
+
+// oneWay reports whether the watch watches one-way trips.
+func (w priceWatch) oneWay() bool {
+	return w.Params.TripType == "one-way"
+}
+
+// parseWatch validates the parameters of a watch and returns the price graph
+// arguments of its remaining travel window as of now, one per trip length. A
+// one-way watch has a single one without a trip length, see watchPrices.
+func (s *server) parseWatch(params createPriceWatchParams, now time.Time) ([]flights.PriceGraphArgs, flights.Options, error) {
+	startDate, err := parseDate("rangeStartDate", params.RangeStartDate)
+	if err != nil {
+		return nil, flights.Options{}, err
+	}
+	endDate, err := parseDate("rangeEndDate", params.RangeEndDate)
+	if err != nil {
+		return nil, flights.Options{}, err
+	}
+	if len(params.SrcCities) == 0 {
+		return nil, flights.Options{}, fmt.Errorf("at least one source city is required")
+	}
+	if len(params.DstCities) == 0 {
+		return nil, flights.Options{}, fmt.Errorf("at least one destination city is required")
+	}
+	if err := s.routePolicy.Check(params.SrcCities, params.DstCities); err != nil {
+		return nil, flights.Options{}, err
+	}
+	lang, err := parseLanguage(params.Language)
+	if err != nil {
+		return nil, flights.Options{}, err
+	}
+	curr, err := parseCurrency(params.Currency)
+	if err != nil {
+		return nil, flights.Options{}, err
+	}
+	options, err := parseSearchOptions(params.searchOptionsParams, lang, curr)
+	if err != nil {
+		return nil, flights.Options{}, err
+	}
+	tripLengths := params.TripLengths
+	if options.TripType == flights.OneWay {
+		if len(tripLengths) > 0 {
+			return nil, flights.Options{}, fmt.Errorf("one-way watches have no tripLengths")
+		}
+		if days := int(endDate.Sub(startDate).Hours()/24) + 1; days > maxOneWayWatchDays {
+			return nil, flights.Options{}, fmt.Errorf("the travel window of a one-way watch spans at most %d days, every check searches each date", maxOneWayWatchDays)
+		}
+		tripLengths = []int{0}
+	} else {
+		if len(tripLengths) == 0 {
+			return nil, flights.Options{}, fmt.Errorf("tripLengths must contain at least one value")
+		}
+		for _, l := range tripLengths {
+			if l <= 0 {
+				return nil, flights.Options{}, fmt.Errorf("tripLengths must be positive values")
+			}
+		}
+	}
+
+	// Departures in the past can't be booked anymore.
+	if today := now.UTC().Truncate(24 * time.Hour); startDate.Before(today) {
+		startDate = today
+	}
+	if endDate.Before(startDate) {
+		return nil, flights.Options{}, fmt.Errorf("the travel window ending %s is over", params.RangeEndDate)
+	}
+
+	args := make([]flights.PriceGraphArgs, 0, len(tripLengths))
+	for _, tripLength := range tripLengths {
+		args = append(args, flights.PriceGraphArgs{
+			RangeStartDate: startDate,
+			RangeEndDate:   endDate,
+			TripLength:     tripLength,
+			SrcCities:      params.SrcCities,
+			DstCities:      params.DstCities,
+			Options:        options,
+		})
+	}
+	return args, options, nil
+}
+
+// watchPrices returns the cheapest price of every departure date of args: the
+// price graph for round trips, a search of every date for one-way trips, which
+// the price graph doesn't cover.
+func (s *server) watchPrices(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	if args.TripType != flights.OneWay {
+		return s.session.GetPriceGraph(ctx, args)
+	}
+	var prices []flights.Offer
+	for date := args.RangeStartDate; !date.After(args.RangeEndDate); date = date.AddDate(0, 0, 1) {
+		offers, _, err := s.session.GetOffers(ctx, flights.Args{
+			Date:       date,
+			ReturnDate: date,
+			SrcCities:  args.SrcCities,
+			DstCities:  args.DstCities,
+			Options:    args.Options,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cheapest := flights.Offer{StartDate: date}
+		for _, o := range offers {
+			if o.Price > 0 && (cheapest.Price == 0 || o.Price < cheapest.Price) {
+				cheapest.Price = o.Price
+			}
+		}
+		prices = append(prices, cheapest)
+	}
+	return prices, nil
+}
+
+// checkWatch looks up the cheapest price of the watch's travel window in Google's
+// price graph. It returns the updated watch and the event to raise, if any.
+func (s *server) checkWatch(ctx context.Context, w priceWatch) (priceWatch, *watchEvent) {
+	w.LastCheckedAt = time.Now().UTC()
+	w.LastError = ""
+
+	graphArgs, options, err := s.parseWatch(w.Params, w.LastCheckedAt)
+	if err != nil {
+		w.LastError = err.Error()
+		return w, nil
+	}
+	var (
+		cheapest   flights.Offer
+		tripLength int
+	)
+	for _, args := range graphArgs {
+		offers, err := s.watchPrices(ctx, args)
+		if err != nil {
+			w.LastError = err.Error()
+			return w, nil
+		}
+		for _, o := range offers {
+			if o.Price > 0 && (cheapest.Price == 0 || o.Price < cheapest.Price) {
+				cheapest, tripLength = o, args.TripLength
+			}
+		}
+	}
+	w.LastPrice = cheapest.Price
+	if cheapest.Price == 0 {
+		w.AlertedPrice = 0
+		return w, nil
+	}
+	if w.LowestPrice == 0 || cheapest.Price < w.LowestPrice {
+		w.LowestPrice = cheapest.Price
+	}
+	// The current price is compared with the checks before it.
+	target, median := w.targetPrice()
+	w.TargetPrice = target
+	w.recordPrice(w.LastCheckedAt, cheapest.Price)
+	if cheapest.Price > target {
+		w.AlertedPrice = 0
+		return w, nil
+	}
+	if w.AlertedPrice > 0 && cheapest.Price >= w.AlertedPrice {
+		return w, nil
+	}
+	w.AlertedPrice = cheapest.Price
+	w.Alerts++
+
+	returnDate := cheapest.ReturnDate
+	if w.oneWay() {
+		returnDate = cheapest.StartDate
+	}
+	link, err := s.session.SerializeURL(ctx, flights.Args{
+		Date:       cheapest.StartDate,
+		ReturnDate: returnDate,
+		SrcCities:  w.Params.SrcCities,
+		DstCities:  w.Params.DstCities,
+		Options:    options,
+	})
+	if err != nil {
+		// The event is worth more than its link.
+		log.Printf("watch %s: serialize URL: %v", w.ID, err)
+	}
+	event := &watchEvent{
+		Kind:          watchEventPriceDrop,
+		WatchID:       w.ID,
+		At:            w.LastCheckedAt.Format(time.RFC3339),
+		Route:         watchRoute(w.Params),
+		Price:         s.roundPrice(cheapest.Price),
+		MaxPrice:      s.roundPrice(w.Params.MaxPrice),
+		DropPercent:   w.Params.DropPercent,
+		Median:        s.roundPrice(median),
+		TargetPrice:   s.roundPrice(target),
+		Currency:      options.Currency.String(),
+		StartDate:     cheapest.StartDate.Format(time.DateOnly),
+		ReturnDate:    cheapest.ReturnDate.Format(time.DateOnly),
+		TripLength:    tripLength,
+		ShareableLink: link,
+	}
+	if w.oneWay() {
+		event.ReturnDate = ""
+	}
+	return w, event
+}
+
+// checkWatches checks every active watch that isn't paused once and notifies the listeners of new
+// events. Watches past their last day expire, with a final event summarizing their
+// checks, and are deleted expiredWatchRetention later.
+func (s *server) checkWatches(ctx context.Context) {
+	if err := s.watches.purgeDeleted(time.Now().UTC()); err != nil {
+		log.Printf("save watches: %v", err)
+	}
+	watches, _ := s.watches.list()
+	raised := false
+	for _, w := range watches {
+		if ctx.Err() != nil {
+			return
+		}
+		now := time.Now().UTC()
+		if !w.ExpiredAt.IsZero() {
+			if now.Sub(w.ExpiredAt) >= expiredWatchRetention {
+				if _, err := s.watches.remove(w.ID); err != nil {
+					log.Printf("save watches: %v", err)
+				}
+			}
+			continue
+		}
+		if w.expired(now) {
+			expired, event := s.expireWatch(w, now)
+			if err := s.watches.update(expired, event); err != nil {
+				log.Printf("save watches: %v", err)
+			}
+			raised = true
+			continue
+		}
+		if w.Paused {
+			continue
+		}
+		checked, event := s.checkWatch(ctx, w)
+		if checked.LastError != "" {
+			log.Printf("watch %s: %s", w.ID, checked.LastError)
+		}
+		if err := s.watches.update(checked, event); err != nil {
+			log.Printf("save watches: %v", err)
+		}
+		raised = raised || event != nil
+	}
+	if raised {
+		s.watches.notifyListeners(ctx)
+	}
+}
+
+// runWatches checks the watches every interval until ctx is done.
+func (s *server) runWatches(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkWatches(backgroundContext(ctx, "watches"))
+		}
+	}
+}
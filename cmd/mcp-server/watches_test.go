@@ -0,0 +1,453 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func watchParams() createPriceWatchParams {
+	start := time.Now().AddDate(0, 1, 0)
+	return createPriceWatchParams{
+		RangeStartDate: start.Format(time.DateOnly),
+		RangeEndDate:   start.AddDate(0, 0, 14).Format(time.DateOnly),
+		TripLengths:    []int{7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+		MaxPrice:       250,
+	}
+}
+
+func TestPriceWatch(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		price = 300.0
+	)
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 1)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return []flights.Offer{
+				{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: price + 50},
+				{StartDate: start.AddDate(0, 0, 1), ReturnDate: start.AddDate(0, 0, 8), Price: price},
+			}, nil
+		},
+	})
+	setPrice := func(p float64) {
+		mu.Lock()
+		defer mu.Unlock()
+		price = p
+	}
+
+	_, created, err := s.createPriceWatch(context.Background(), nil, watchParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Event != nil || created.Watch.LastPrice != 300 || created.Watch.BelowTarget {
+		t.Fatalf("expected no event above the target: %+v", created)
+	}
+
+	// Every drop to or below the target raises one event, until the price rises
+	// above the target again.
+	for _, p := range []float64{240, 240, 245, 230, 260, 250} {
+		setPrice(p)
+		s.checkWatches(context.Background())
+	}
+	_, listed, err := s.listPriceWatches(context.Background(), nil, listPriceWatchesParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var prices []float64
+	for _, e := range listed.Events {
+		prices = append(prices, e.Price)
+	}
+	if !reflect.DeepEqual(prices, []float64{250, 230, 240}) {
+		t.Fatalf("expected events at 240, 230 and 250, newest first, got %v", prices)
+	}
+	event := listed.Events[0]
+	if event.WatchID != created.Watch.ID || event.StartDate != start.AddDate(0, 0, 1).Format(time.DateOnly) || event.TripLength != 7 || event.ShareableLink == "" {
+		t.Fatalf("wrong event: %+v", event)
+	}
+	if len(listed.Watches) != 1 || !listed.Watches[0].BelowTarget {
+		t.Fatalf("wrong watches: %+v", listed.Watches)
+	}
+
+	if _, _, err := s.deletePriceWatch(context.Background(), nil, deletePriceWatchParams{WatchID: created.Watch.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.deletePriceWatch(context.Background(), nil, deletePriceWatchParams{WatchID: created.Watch.ID}); err == nil {
+		t.Fatal("expected an error for an unknown watch")
+	}
+}
+
+func TestCreatePriceWatchValidates(t *testing.T) {
+	s := newTestServer(t, &fakeSession{})
+	params := watchParams()
+	params.MaxPrice = 0
+	if _, _, err := s.createPriceWatch(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error without a target price")
+	}
+	params = watchParams()
+	params.RangeStartDate = time.Now().AddDate(0, 0, -20).Format(time.DateOnly)
+	params.RangeEndDate = time.Now().AddDate(0, 0, -10).Format(time.DateOnly)
+	if _, _, err := s.createPriceWatch(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error for a travel window in the past")
+	}
+}
+
+func TestOneWayPriceWatch(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 0)
+	var searched []flights.Args
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			t.Error("the price graph doesn't cover one-way trips")
+			return nil, nil
+		},
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			searched = append(searched, args)
+			price := 150.0
+			if args.Date.Equal(start.AddDate(0, 0, 1)) {
+				price = 90
+			}
+			return []flights.FullOffer{{Offer: flights.Offer{StartDate: args.Date, Price: price}}}, nil, nil
+		},
+	})
+
+	params := watchParams()
+	params.TripLengths = nil
+	params.TripType = "one-way"
+	params.RangeStartDate = start.Format(time.DateOnly)
+	params.RangeEndDate = start.AddDate(0, 0, 2).Format(time.DateOnly)
+	params.MaxPrice = 100
+	_, created, err := s.createPriceWatch(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(searched) != 3 || searched[0].TripType != flights.OneWay {
+		t.Fatalf("expected a one-way search per date, got %+v", searched)
+	}
+	event := created.Event
+	if event == nil || event.Price != 90 || event.StartDate != start.AddDate(0, 0, 1).Format(time.DateOnly) ||
+		event.ReturnDate != "" || event.TripLength != 0 || event.Route != "San Francisco -> New York (one-way)" {
+		t.Fatalf("wrong event: %+v", event)
+	}
+	if !created.Watch.OneWay || created.Watch.LastPrice != 90 {
+		t.Fatalf("wrong watch: %+v", created.Watch)
+	}
+
+	params.TripLengths = []int{7}
+	if _, _, err := s.createPriceWatch(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error for a one-way watch with trip lengths")
+	}
+	params.TripLengths = nil
+	params.RangeEndDate = start.AddDate(0, 0, maxOneWayWatchDays).Format(time.DateOnly)
+	if _, _, err := s.createPriceWatch(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error for a one-way window longer than maxOneWayWatchDays")
+	}
+}
+
+func TestWatchListPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watches.json")
+	watches, err := loadWatchList(context.Background(), newMemoryStore(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := priceWatch{ID: "abc", Params: watchParams(), CreatedAt: time.Now().UTC().Truncate(time.Second)}
+	if err := watches.add(w); err != nil {
+		t.Fatal(err)
+	}
+	w.LastPrice = 240
+	if err := watches.update(w, &watchEvent{WatchID: "abc", Price: 240}); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := loadWatchList(context.Background(), newMemoryStore(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadedWatches, loadedEvents := reloaded.list()
+	if len(loadedWatches) != 1 || loadedWatches[0].LastPrice != 240 || !loadedWatches[0].CreatedAt.Equal(w.CreatedAt) {
+		t.Fatalf("watch not persisted: %+v", loadedWatches)
+	}
+	if len(loadedEvents) != 1 || loadedEvents[0].Price != 240 {
+		t.Fatalf("event not persisted: %+v", loadedEvents)
+	}
+}
+
+func TestWatchListUpdateKeepsPause(t *testing.T) {
+	watches, err := loadWatchList(context.Background(), newMemoryStore(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := watchParams()
+	params.Trip = "summer"
+	if err := watches.add(priceWatch{ID: "abc", Params: params}); err != nil {
+		t.Fatal(err)
+	}
+
+	// pause_trip runs while the watch is checked.
+	listed, _ := watches.list()
+	checked := listed[0]
+	if _, err := watches.setTripPaused("summer", true); err != nil {
+		t.Fatal(err)
+	}
+	checked.LastCheckedAt = time.Now().UTC()
+	checked.LastPrice = 240
+	checked.Alerts = 1
+	if err := watches.update(checked, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	listed, _ = watches.list()
+	if w := listed[0]; !w.Paused || w.LastPrice != 240 || w.Alerts != 1 || !w.LastCheckedAt.Equal(checked.LastCheckedAt) {
+		t.Fatalf("expected the check to be recorded and the watch to stay paused: %+v", w)
+	}
+}
+
+// failingWatchStore fails to save the watches while err is set.
+type failingWatchStore struct {
+	err error
+}
+
+func (f *failingWatchStore) LoadWatches(context.Context) (watchListFile, error) {
+	return watchListFile{}, nil
+}
+
+func (f *failingWatchStore) SaveWatches(context.Context, watchListFile) error {
+	return f.err
+}
+
+func TestWatchListAddRollback(t *testing.T) {
+	st := &failingWatchStore{err: errors.New("disk full")}
+	watches, err := loadWatchList(context.Background(), st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := watches.add(priceWatch{ID: "abc", Params: watchParams()}); !errors.Is(err, st.err) {
+		t.Fatalf("expected the save error, got %v", err)
+	}
+	if listed, _ := watches.list(); len(listed) != 0 {
+		t.Fatalf("expected the unsaved watch to be dropped: %+v", listed)
+	}
+
+	st.err = nil
+	if err := watches.add(priceWatch{ID: "def", Params: watchParams()}); err != nil {
+		t.Fatal(err)
+	}
+	if listed, _ := watches.list(); len(listed) != 1 || listed[0].ID != "def" {
+		t.Fatalf("expected only the saved watch: %+v", listed)
+	}
+}
+
+func TestRestoreWatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watches.json")
+	watches, err := loadWatchList(context.Background(), newMemoryStore(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newTestServer(t, &fakeSession{})
+	s.watches = watches
+	if err := watches.add(priceWatch{ID: "abc", Params: watchParams(), LastPrice: 240}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, deleted, err := s.deletePriceWatch(context.Background(), nil, deletePriceWatchParams{WatchID: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.RestorableUntil == "" {
+		t.Fatalf("expected the end of the retention: %+v", deleted)
+	}
+	if listed, _ := watches.list(); len(listed) != 0 {
+		t.Fatalf("expected the deleted watch not to be listed: %+v", listed)
+	}
+
+	// The deleted watch survives a restart.
+	if s.watches, err = loadWatchList(context.Background(), newMemoryStore(path)); err != nil {
+		t.Fatal(err)
+	}
+	_, restored, err := s.restoreWatch(context.Background(), nil, restoreWatchParams{WatchID: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Watch.ID != "abc" || restored.Watch.LastPrice != 240 {
+		t.Fatalf("wrong restored watch: %+v", restored.Watch)
+	}
+	if _, _, err := s.restoreWatch(context.Background(), nil, restoreWatchParams{WatchID: "abc"}); err == nil {
+		t.Fatal("expected an error restoring a watch twice")
+	}
+
+	// Past the retention, the deleted watch is gone.
+	now := time.Now().UTC()
+	if _, err := s.watches.softDelete("abc", now.Add(-deletedRetention)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.watches.purgeDeleted(now); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := s.watches.restore("abc", now.Add(-deletedRetention)); ok || err != nil {
+		t.Fatalf("expected the purged watch to be gone: %v %v", ok, err)
+	}
+}
+
+func TestWatchEventsResourceNotifiesSubscribers(t *testing.T) {
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			date := args.RangeStartDate.AddDate(0, 0, 3)
+			return []flights.Offer{{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: 200}}, nil
+		},
+	})
+	updated := make(chan string, 1)
+	clientSession := connectClient(t, s, &mcp.ClientOptions{
+		ResourceUpdatedHandler: func(ctx context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+			updated <- req.Params.URI
+		},
+	})
+	ctx := context.Background()
+	if err := clientSession.Subscribe(ctx, &mcp.SubscribeParams{URI: watchEventsURI}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := s.createPriceWatch(ctx, nil, watchParams()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case uri := <-updated:
+		if uri != watchEventsURI {
+			t.Fatalf("wrong resource updated: %s", uri)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscriber wasn't notified")
+	}
+
+	result, err := clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: watchEventsURI})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Contents) != 1 || result.Contents[0].Text == "[]" {
+		t.Fatalf("expected the event in the resource: %+v", result.Contents)
+	}
+}
+
+func TestPriceWatchDropPercent(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		price = 300.0
+	)
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 1)
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: price}}, nil
+		},
+	})
+	setPrice := func(p float64) {
+		mu.Lock()
+		defer mu.Unlock()
+		price = p
+	}
+
+	params := watchParams()
+	params.MaxPrice = 0
+	params.DropPercent = 15
+	_, created, err := s.createPriceWatch(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Watch.BaselineDays != defaultBaselineDays {
+		t.Fatalf("expected the default baseline, got %d", created.Watch.BaselineDays)
+	}
+
+	// A cheap price before there is a baseline isn't a drop. The median of the
+	// checks before 255 (300, 200, 300, 320 and 260) is 300, so 255 is a 15% drop.
+	for _, p := range []float64{200, 300, 320, 260, 255} {
+		setPrice(p)
+		s.checkWatches(context.Background())
+	}
+	listed, events := s.watches.list()
+	if len(events) != 1 || events[0].Price != 255 || events[0].Median != 300 || events[0].TargetPrice != 255 {
+		t.Fatalf("expected one event at 255 against a median of 300, got %+v", events)
+	}
+	if len(listed[0].History) != 6 {
+		t.Fatalf("expected every check in the history, got %+v", listed[0].History)
+	}
+
+	// Checks older than the baseline are forgotten.
+	w := listed[0]
+	w.recordPrice(w.LastCheckedAt.AddDate(0, 0, defaultBaselineDays+1), 280)
+	if len(w.History) != 1 {
+		t.Fatalf("expected old checks to be forgotten, got %+v", w.History)
+	}
+}
+
+func TestPriceWatchExpiry(t *testing.T) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 1, 1)
+	checks := 0
+	s := newTestServer(t, &fakeSession{
+		getPriceGraph: func(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+			checks++
+			return []flights.Offer{{StartDate: start, ReturnDate: start.AddDate(0, 0, 7), Price: 240}}, nil
+		},
+	})
+	params := watchParams()
+	params.ExpiresAt = time.Now().UTC().Format(time.DateOnly)
+	_, created, err := s.createPriceWatch(context.Background(), nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Watch.ExpiresAt != params.ExpiresAt || created.Watch.Expired {
+		t.Fatalf("wrong expiry: %+v", created.Watch)
+	}
+
+	// The watch expires once its last day is over.
+	watches, _ := s.watches.list()
+	w := watches[0]
+	if w.expired(time.Now().UTC()) || !w.expired(time.Now().UTC().AddDate(0, 0, 1).Truncate(24*time.Hour)) {
+		t.Fatal("expected the watch to expire at the end of expiresAt")
+	}
+	expired, event := s.expireWatch(w, time.Now().UTC())
+	if err := s.watches.update(expired, event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Kind != watchEventExpired || event.LowestPrice != 240 || event.Alerts != 1 {
+		t.Fatalf("wrong summary event: %+v", event)
+	}
+
+	// Expired watches aren't checked anymore and are deleted after the retention.
+	checks = 0
+	s.checkWatches(context.Background())
+	watches, _ = s.watches.list()
+	if checks != 0 || len(watches) != 1 || !s.newWatchResponse(watches[0]).Expired {
+		t.Fatalf("expected the expired watch to be kept unchecked, got %d check(s) and %+v", checks, watches)
+	}
+	watches[0].ExpiredAt = watches[0].ExpiredAt.Add(-expiredWatchRetention)
+	if err := s.watches.update(watches[0], nil); err != nil {
+		t.Fatal(err)
+	}
+	s.checkWatches(context.Background())
+	if watches, _ = s.watches.list(); len(watches) != 0 {
+		t.Fatalf("expected the expired watch to be deleted, got %+v", watches)
+	}
+}
+
+func TestPriceWatchExpiresAtDefaultsToWindowEnd(t *testing.T) {
+	params := watchParams()
+	w := priceWatch{Params: params}
+	if w.expiresAt() != params.RangeEndDate {
+		t.Fatalf("expected the end of the travel window, got %s", w.expiresAt())
+	}
+	s := newTestServer(t, &fakeSession{})
+	params.ExpiresAt = time.Now().AddDate(0, 0, -2).Format(time.DateOnly)
+	if _, _, err := s.createPriceWatch(context.Background(), nil, params); err == nil {
+		t.Fatal("expected an error for an expiry in the past")
+	}
+}
@@ -36,7 +36,7 @@ func main() {
 		Lang:      language.English,
 	}
 
-	results, err := cheapoffers.Find(
+	results, _, err := cheapoffers.Find(
 		context.Background(),
 		session,
 		cheapoffers.Args{
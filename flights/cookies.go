@@ -0,0 +1,139 @@
+package flights
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// CookieJar holds the cookies Google sets. It is safe for concurrent use by multiple
+// goroutines; sessions sharing a jar look like one consistent client to Google.
+type CookieJar struct {
+	mu      sync.Mutex
+	path    string
+	cookies []string
+	unsaved bool // the last attempt to persist the jar failed
+}
+
+// NewCookieJar creates a cookie jar persisted to path and loads the cookies stored
+// there. If path is empty, the cookies are kept in memory only.
+func NewCookieJar(path string) (*CookieJar, error) {
+	jar := &CookieJar{path: path}
+	if path == "" {
+		return jar, nil
+	}
+	cookies, err := loadCookies(path)
+	if err != nil {
+		return nil, err
+	}
+	jar.cookies = cookies
+	return jar, nil
+}
+
+// Cookies returns the cookies of the jar in the "name=value" form.
+func (j *CookieJar) Cookies() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]string(nil), j.cookies...)
+}
+
+// update merges cookies into the jar and persists the jar if it changed.
+func (j *CookieJar) update(cookies []string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	merged := mergeCookies(j.cookies, cookies)
+	if slices.Equal(merged, j.cookies) && !j.unsaved {
+		return nil
+	}
+	j.cookies = merged
+	if j.path == "" {
+		return nil
+	}
+	err := saveCookies(j.path, merged)
+	j.unsaved = err != nil
+	return err
+}
+
+// WithCookieJar makes the session use and update jar, e.g. to share cookies between
+// several sessions.
+func WithCookieJar(jar *CookieJar) SessionOption {
+	return func(s *Session) {
+		s.jar = jar
+	}
+}
+
+// WithCookieFile persists the session cookies to path. It is a shorthand for a
+// [CookieJar] used by this session only. Cookies stored by a previous session are sent
+// with the request that initializes the session, so consent decisions survive restarts.
+func WithCookieFile(path string) SessionOption {
+	return func(s *Session) {
+		s.cookieFile = path
+	}
+}
+
+// WithConsentCookies adds cookies in the "name=value" form that are sent with every
+// request, e.g. a SOCS or CONSENT cookie accepting Google's consent interstitial.
+// They take precedence over the cookies set by Google.
+func WithConsentCookies(cookies ...string) SessionOption {
+	return func(s *Session) {
+		s.consentCookies = append(s.consentCookies, cookies...)
+	}
+}
+
+func validateCookies(cookies []string) error {
+	for _, c := range cookies {
+		name, _, ok := strings.Cut(c, "=")
+		if !ok || strings.TrimSpace(name) == "" || strings.ContainsAny(c, ";\r\n") {
+			return fmt.Errorf("invalid cookie %q, expected name=value", c)
+		}
+	}
+	return nil
+}
+
+// mergeCookies returns the cookies of base, replaced or complemented by the
+// cookies of override with the same name.
+func mergeCookies(base, override []string) []string {
+	merged := make([]string, 0, len(base)+len(override))
+	index := map[string]int{}
+	for _, cookies := range [][]string{base, override} {
+		for _, c := range cookies {
+			name, _, _ := strings.Cut(c, "=")
+			if i, ok := index[name]; ok {
+				merged[i] = c
+				continue
+			}
+			index[name] = len(merged)
+			merged = append(merged, c)
+		}
+	}
+	return merged
+}
+
+func loadCookies(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cookies []string
+	if err := json.Unmarshal(data, &cookies); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", path, err)
+	}
+	return cookies, validateCookies(cookies)
+}
+
+func saveCookies(path string, cookies []string) error {
+	data, err := json.MarshalIndent(cookies, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
@@ -0,0 +1,78 @@
+package flights
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestMergeCookies(t *testing.T) {
+	merged := mergeCookies(
+		[]string{"NID=1", "AEC=old", "SOCS=stored"},
+		[]string{"AEC=new", "CONSENT=YES+"},
+	)
+	if diff := deep.Equal(merged, []string{"NID=1", "AEC=new", "SOCS=stored", "CONSENT=YES+"}); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestValidateCookies(t *testing.T) {
+	if err := validateCookies([]string{"SOCS=CAESEwgDEgk0ODE3Nzk3MjQaAmVuIAEaBgiA_LyaBg", "CONSENT=YES+"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []string{"SOCS", "=value", "a=b; c=d"} {
+		if err := validateCookies([]string{c}); err == nil {
+			t.Fatalf("expected an error for cookie %q", c)
+		}
+	}
+}
+
+func TestSaveLoadCookies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cookies.json")
+
+	cookies, err := loadCookies(path)
+	if err != nil {
+		t.Fatalf("a missing cookie file should not be an error: %v", err)
+	}
+	if len(cookies) != 0 {
+		t.Fatalf("expected no cookies, got: %v", cookies)
+	}
+
+	if err := saveCookies(path, []string{"NID=1", "SOCS=2"}); err != nil {
+		t.Fatal(err)
+	}
+	cookies, err = loadCookies(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(cookies, []string{"NID=1", "SOCS=2"}); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestCookieJarShared(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cookies.json")
+	jar, err := NewCookieJar(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := &Session{jar: jar}
+	second := &Session{jar: jar, consentCookies: []string{"SOCS=consent"}}
+	if err := first.jar.update([]string{"NID=1"}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(second.requestCookies(), []string{"NID=1", "SOCS=consent"}); diff != nil {
+		t.Fatal(diff)
+	}
+
+	// A restarted server picks up the persisted cookies.
+	restored, err := NewCookieJar(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(restored.Cookies(), []string{"NID=1"}); diff != nil {
+		t.Fatal(diff)
+	}
+}
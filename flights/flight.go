@@ -118,7 +118,7 @@ func (s *Session) getFlightReqData(ctx context.Context, args Args) (string, erro
 }
 
 func (s *Session) doRequestFlights(ctx context.Context, args Args) (*http.Response, error) {
-	url := "https://www.google.com/_/FlightsFrontendUi/data/travel.frontend.flights.FlightsFrontendService/GetShoppingResults?f.sid=-1300922759171628473&bl=boq_travel-frontend-ui_20230627.02_p1&hl=en&soc-app=162&soc-platform=1&soc-device=1&_reqid=52717&rt=c"
+	url := "https://www.google.com/_/FlightsFrontendUi/data/travel.frontend.flights.FlightsFrontendService/GetShoppingResults?f.sid=-1300922759171628473&bl=boq_travel-frontend-ui_20230627.02_p1&hl=en&soc-app=162&soc-platform=1&soc-device=1&_reqid=52717&rt=c" + s.marketParam()
 
 	reqDate, err := s.getFlightReqData(ctx, args)
 	if err != nil {
@@ -137,13 +137,12 @@ func (s *Session) doRequestFlights(ctx context.Context, args Args) (*http.Respon
 	req.Header.Set("accept-language", `en-US,en;q=0.9`)
 	req.Header.Set("cache-control", `no-cache`)
 	req.Header.Set("content-type", `application/x-www-form-urlencoded;charset=UTF-8`)
-	req.Header["cookie"] = s.cookies
 	req.Header.Set("pragma", `no-cache`)
 	req.Header.Set("user-agent", `Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/113.0.0.0 Safari/537.36`)
 	req.Header.Set("x-goog-ext-259736195-jspb",
 		fmt.Sprintf(`["en-US","US","%s",1,null,[-120],null,[[48676280,48710756,47907128,48764689,48627726,48480739,48593234,48707380]],1,[]]`, args.Currency)) // language, location, Currency
 
-	return s.client.Do(req)
+	return s.do(req)
 }
 
 func getFlightsDuration(flights []Flight) time.Duration {
@@ -276,7 +275,9 @@ func getSubsectionOffers(rawOffers []json.RawMessage, returnDate time.Time) ([]F
 }
 
 func sectionOffersSchema(rawOffers1, rawOffers2 *[]json.RawMessage, priceRange *PriceRange) *[]interface{} {
-	return &[]interface{}{nil, nil, &[]interface{}{rawOffers1}, &[]interface{}{rawOffers2}, nil, &[]interface{}{nil, nil, nil, nil,
+	insight := &priceRange.Insight
+	return &[]interface{}{nil, nil, &[]interface{}{rawOffers1}, &[]interface{}{rawOffers2}, nil, &[]interface{}{&insight.Level,
+		&[]interface{}{nil, &insight.Cheapest}, &[]interface{}{nil, &insight.Usual}, nil,
 		&[]interface{}{nil, &priceRange.Low}, &[]interface{}{nil, &priceRange.High}}}
 }
 
@@ -308,9 +309,9 @@ func getSectionOffers(bytesToDecode []byte, returnDate time.Time) ([]FullOffer,
 // GetOffers retrieves offers from the Google Flight search. The city names should be provided in the language
 // described by args.Lang. The offers are returned in a slice of [FullOffer].
 //
-// GetOffers also returns [*PriceRange], which contains the low and high prices of the search. The values are
-// taken from the "View price history" subsection of the search. If the search doesn't have the "View
-// price history" subsection, then GetOffers returns nil.
+// GetOffers also returns [*PriceRange], which contains the low and high prices of the search and Google's
+// [PriceInsight] into them. The values are taken from the "View price history" subsection of the search. If
+// the search doesn't have the "View price history" subsection, then GetOffers returns nil.
 //
 // GetPriceGraph returns an error if any of the requests fail or if any of the city names are misspelled.
 //
@@ -215,7 +215,11 @@ func TestGetOffersMock(t *testing.T) {
 		DstCity:        "Athens",
 		FlightDuration: d3,
 	}
-	expectedPriceRange := PriceRange{1300, 2300}
+	expectedPriceRange := PriceRange{
+		Low:     1300,
+		High:    2300,
+		Insight: PriceInsight{Level: PriceLevelTypical, Cheapest: 1315, Usual: 1562},
+	}
 
 	httpClientMock, err := newHttpClientMock(
 		t,
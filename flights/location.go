@@ -14,6 +14,7 @@ import (
 
 	anyascii "github.com/anyascii/go"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/krisukox/google-flights-api/iata"
 	"golang.org/x/text/language"
 )
 
@@ -23,7 +24,7 @@ func getCityReqData(city string) string {
 
 func (s *Session) doRequestLocation(ctx context.Context, city string, lang language.Tag) (*http.Response, error) {
 	requestURL := "https://www.google.com/_/FlightsFrontendUi/data/batchexecute?rpcids=H028ib&source-path=%2Ftravel%2Fflights%2Fsearch&f.sid=-8421128425468344897&bl=boq_travel-frontend-ui_20230613.06_p0" +
-		"&hl=" + lang.String() +
+		"&hl=" + lang.String() + s.marketParam() +
 		"&soc-app=162&soc-platform=1&soc-device=1&_reqid=444052&rt=c"
 
 	jsonBody := []byte(
@@ -37,11 +38,10 @@ func (s *Session) doRequestLocation(ctx context.Context, city string, lang langu
 	req.Header.Set("accept", "*/*")
 	req.Header.Set("cache-control", "no-cache")
 	req.Header.Set("content-type", "application/x-www-form-urlencoded;charset=UTF-8")
-	req.Header["cookie"] = s.cookies
 	req.Header.Set("pragma", "no-cache")
 	req.Header.Set("user-agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/113.0.0.0 Safari/537.36")
 
-	return s.client.Do(req)
+	return s.do(req)
 }
 
 func abbrCitySchema(city, abbrCity *string) *[][][][]interface{} {
@@ -75,10 +75,15 @@ func compareStrLatin(lv, rv string) bool {
 // be provided in the language described by [language.Tag].
 //
 // AbbrCity returns an error if the city name is misspelled or the Google Flights API returns an unexpected response.
+// Names that don't match are remembered per language and not requested again.
 func (s *Session) AbbrCity(ctx context.Context, city string, lang language.Tag) (string, error) {
 	if abbrCity, ok := s.Cities.Load(city); ok {
 		return abbrCity, nil
 	}
+	missingKey := lang.String() + "|" + city
+	if err, ok := s.missingCities.Load(missingKey); ok {
+		return "", err
+	}
 
 	resp, err := s.doRequestLocation(ctx, city, lang)
 	if err != nil {
@@ -104,18 +109,45 @@ func (s *Session) AbbrCity(ctx context.Context, city string, lang language.Tag)
 	}
 
 	if !compareStrLatin(city, receivedCity) {
-		return "", fmt.Errorf("the requested city name didn't match the found. requested: %s found: %s", city, receivedCity)
+		err := fmt.Errorf("the requested city name didn't match the found. requested: %s found: %s", city, receivedCity)
+		s.missingCities.Store(missingKey, err)
+		return "", err
 	}
 
 	s.Cities.Store(receivedCity, abbrCity)
+	// The requested spelling may differ in case or accents, it's asked for again.
+	s.Cities.Store(city, abbrCity)
 
 	return abbrCity, nil
 }
 
+// abbrCities serializes the city names. A name the name table of package iata
+// knows is looked up by its name in lang, e.g. Köln as Cologne in English, which
+// costs no further request; the other names that don't match in lang are looked
+// up in the languages of [WithCityLanguages] as well.
 func (s *Session) abbrCities(ctx context.Context, cities []string, lang language.Tag) ([]string, error) {
 	abbrCities := []string{}
 	for _, c := range cities {
-		sc, err := s.AbbrCity(ctx, c, lang)
+		var sc string
+		var err error
+		if translated, ok := iata.TranslateCity(c, lang); ok && translated != c {
+			if sc, err = s.AbbrCity(ctx, translated, lang); err == nil {
+				s.Cities.Store(c, sc)
+			}
+		} else {
+			sc, err = s.AbbrCity(ctx, c, lang)
+		}
+		for _, cityLang := range s.cityLangs {
+			if err == nil || ctx.Err() != nil {
+				break
+			}
+			if cityLang == lang {
+				continue
+			}
+			if abbrCity, langErr := s.AbbrCity(ctx, c, cityLang); langErr == nil {
+				sc, err = abbrCity, nil
+			}
+		}
 		if err != nil {
 			return nil, fmt.Errorf("could not get the abbreviated %s city name: %v", c, err)
 		}
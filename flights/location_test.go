@@ -90,6 +90,63 @@ func TestAbbrCityPL(t *testing.T) {
 	}
 }
 
+func TestAbbrCityLanguagesMock(t *testing.T) {
+	// The lookup in Polish finds another city, the one in English the requested one.
+	httpClientMock, err := newHttpClientMock(
+		t,
+		"testdata/city_warsaw.resp",
+		"testdata/city_athens.resp",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := &Session{
+		client:    httpClientMock,
+		cityLangs: []language.Tag{language.Polish, language.English},
+	}
+
+	for i := 0; i < 2; i++ { // the second time from the cache
+		cities, err := session.abbrCities(context.Background(), []string{"athens"}, language.Polish)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cities) != 1 || cities[0] != abbrA {
+			t.Fatalf("wrong abbreviated city names, expected: [%s] received: %v", abbrA, cities)
+		}
+	}
+
+	session.cityLangs = nil
+	session.Cities = Map[string, string]{}
+	if httpClientMock, err = newHttpClientMock(t, "testdata/city_warsaw.resp"); err != nil {
+		t.Fatal(err)
+	}
+	session.client = httpClientMock
+	for i := 0; i < 2; i++ { // the second time from the cache of names that didn't match
+		if _, err := session.abbrCities(context.Background(), []string{"Athens"}, language.Polish); err == nil {
+			t.Fatal("expected an error without further city languages")
+		}
+	}
+}
+
+func TestAbbrCitiesNameTableMock(t *testing.T) {
+	// The German name is looked up by the English one of the name table.
+	httpClientMock, err := newHttpClientMock(t, "testdata/city_athens.resp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := &Session{client: httpClientMock}
+
+	for i := 0; i < 2; i++ { // the second time from the cache
+		cities, err := session.abbrCities(context.Background(), []string{"Athen"}, language.English)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cities) != 1 || cities[0] != abbrA {
+			t.Fatalf("wrong abbreviated city names, expected: [%s] received: %v", abbrA, cities)
+		}
+	}
+}
+
 func TestAbbrCity(t *testing.T) {
 	httpClientMock, err := newHttpClientMock(
 		t,
@@ -39,7 +39,7 @@ func (s *Session) getPriceGraphReqData(ctx context.Context, args PriceGraphArgs)
 }
 
 func (s *Session) doRequestPriceGraph(ctx context.Context, args PriceGraphArgs) (*http.Response, error) {
-	url := "https://www.google.com/_/FlightsFrontendUi/data/travel.frontend.flights.FlightsFrontendService/GetCalendarGraph?f.sid=-8920707734915550076&bl=boq_travel-frontend-ui_20230627.07_p1&hl=en&soc-app=162&soc-platform=1&soc-device=1&_reqid=261464&rt=c"
+	url := "https://www.google.com/_/FlightsFrontendUi/data/travel.frontend.flights.FlightsFrontendService/GetCalendarGraph?f.sid=-8920707734915550076&bl=boq_travel-frontend-ui_20230627.07_p1&hl=en&soc-app=162&soc-platform=1&soc-device=1&_reqid=261464&rt=c" + s.marketParam()
 
 	reqDate, err := s.getPriceGraphReqData(ctx, args)
 	if err != nil {
@@ -58,13 +58,12 @@ func (s *Session) doRequestPriceGraph(ctx context.Context, args PriceGraphArgs)
 	req.Header.Set("accept-language", `en-US,en;q=0.9`)
 	req.Header.Set("cache-control", `no-cache`)
 	req.Header.Set("content-type", `application/x-www-form-urlencoded;charset=UTF-8`)
-	req.Header["cookie"] = s.cookies
 	req.Header.Set("pragma", `no-cache`)
 	req.Header.Set("user-agent", `Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36`)
 	req.Header.Set("x-goog-ext-259736195-jspb",
 		fmt.Sprintf(`["en-US","US","%s",1,null,[-120],null,[[48764689,47907128,48676280,48710756,48627726,48480739,48593234,48707380]],1,[]]`, args.Currency))
 
-	return s.client.Do(req)
+	return s.do(req)
 }
 
 func priceGraphSchema(startDate, returnDate *string, price *float64) *[]interface{} {
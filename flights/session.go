@@ -2,15 +2,19 @@
 package flights
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"path"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/browserutils/kooky"
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/text/language"
 )
 
 // Map is safe for concurrent use by multiple goroutines. This is a wrapper around
@@ -42,12 +46,74 @@ type httpClient interface {
 type Session struct {
 	Cities Map[string, string] // Map which acts like a cache: city name -> abbravated city names
 
-	client  httpClient
-	cookies []string
+	missingCities Map[string, error] // language|city name -> the error of a city name that didn't match
+
+	client httpClient
+	market string // ISO 3166-1 alpha-2 country of sale, empty if Google derives it from the IP address
+
+	jar            *CookieJar // cookies set by Google, possibly shared with other sessions
+	cookieFile     string     // file the jar is persisted to if no jar is given
+	consentCookies []string   // cookies overriding the ones set by Google
+	abuseExemption string     // GOOGLE_ABUSE_EXEMPTION cookie read from the browser
+
+	cityLangs []language.Tag // further languages city names are looked up in
+
+	record func(endpoint string, body []byte) // set by WithResponseRecorder
+}
+
+// SessionOption configures a [Session] created by [New].
+type SessionOption func(*Session)
+
+// WithMarket sets the country of sale (ISO 3166-1 alpha-2 code, e.g. "DE") used for all
+// requests of the session. Fares and availability legitimately differ between markets.
+// By default Google derives the market from the IP address of the client.
+func WithMarket(country string) SessionOption {
+	return func(s *Session) {
+		s.market = country
+	}
+}
+
+// WithCityLanguages makes the session look up the city names of a request that
+// don't match in the language of the request in these languages as well, in order.
+// A search in English then accepts "Mailand" given German even though the name
+// table of package iata, which is tried first, doesn't know it. Every further
+// language costs a request for a name that doesn't match; names found and names
+// that didn't match are cached.
+func WithCityLanguages(langs ...language.Tag) SessionOption {
+	return func(s *Session) {
+		s.cityLangs = langs
+	}
+}
+
+// WithResponseRecorder makes the session pass the raw body of every Google Flights
+// response to record before it's parsed, together with the endpoint it came from,
+// e.g. GetShoppingResults. It's meant for recording the responses as test fixtures.
+func WithResponseRecorder(record func(endpoint string, body []byte)) SessionOption {
+	return func(s *Session) {
+		s.record = record
+	}
+}
+
+// Market returns the country of sale used by the session, or an empty string if
+// Google derives it from the IP address of the client.
+func (s *Session) Market() string {
+	return s.market
+}
+
+// marketParam returns the query parameter selecting the market of the session.
+func (s *Session) marketParam() string {
+	if s.market == "" {
+		return ""
+	}
+	return "&gl=" + s.market
 }
 
 func customRetryPolicy() func(ctx context.Context, resp *http.Response, err error) (bool, error) {
 	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		// Don't retry once the caller has given up, e.g. because the client disconnected.
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
 		if resp == nil {
 			return true, fmt.Errorf("response is nil")
 		}
@@ -70,32 +136,105 @@ func getCookies(res *http.Response) ([]string, error) {
 	return nil, fmt.Errorf("could not find the 'Set-Cookie' header in the initialization response")
 }
 
-func New() (*Session, error) {
+func New(opts ...SessionOption) (*Session, error) {
+	session := &Session{}
+	for _, opt := range opts {
+		opt(session)
+	}
+	if session.market != "" {
+		region, err := language.ParseRegion(session.market)
+		if err != nil || !region.IsCountry() {
+			return nil, fmt.Errorf("new session: invalid market %q, expected an ISO 3166-1 alpha-2 country code", session.market)
+		}
+		session.market = region.String()
+	}
+	if err := validateCookies(session.consentCookies); err != nil {
+		return nil, fmt.Errorf("new session: %v", err)
+	}
+
+	if session.jar == nil {
+		jar, err := NewCookieJar(session.cookieFile)
+		if err != nil {
+			return nil, fmt.Errorf("new session: err loading cookies: %v", err)
+		}
+		session.jar = jar
+	} else if session.cookieFile != "" {
+		return nil, fmt.Errorf("new session: a cookie file and a cookie jar can't be used together")
+	}
+	initialCookies := session.requestCookies()
+
 	client := retryablehttp.NewClient()
 	client.RetryMax = 5
 	client.Logger = nil
 	client.CheckRetry = customRetryPolicy()
 	client.RetryWaitMin = time.Second
 
-	res, err := client.Get("https://www.google.com/")
+	req, err := retryablehttp.NewRequest(http.MethodGet, "https://www.google.com/", nil)
+	if err != nil {
+		return nil, fmt.Errorf("new session: %v", err)
+	}
+	req.Header["cookie"] = initialCookies
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("new session: err sending request to www.google.com: %v", err)
 	}
+	res.Body.Close()
 
-	cookies, err := getCookies(res)
-	if err != nil {
+	// Google doesn't set cookies again if the stored ones are still valid.
+	setCookies, err := getCookies(res)
+	if err != nil && len(initialCookies) == 0 {
 		return nil, fmt.Errorf("new session: err getting cookies: %v", err)
 	}
+	if err := session.jar.update(setCookies); err != nil {
+		return nil, fmt.Errorf("new session: err saving cookies: %v", err)
+	}
 
 	GOOGLE_ABUSE_EXEMPTION := kooky.ReadCookies(kooky.Valid, kooky.DomainHasSuffix(`google.com`), kooky.Name(`GOOGLE_ABUSE_EXEMPTION`))
 
 	if len(GOOGLE_ABUSE_EXEMPTION) == 1 {
-		cookies = append(cookies, GOOGLE_ABUSE_EXEMPTION[0].Value)
+		session.abuseExemption = GOOGLE_ABUSE_EXEMPTION[0].Value
+	}
+
+	session.client = client
+	return session, nil
+}
+
+// requestCookies returns the cookies sent with every request.
+func (s *Session) requestCookies() []string {
+	var cookies []string
+	if s.jar != nil {
+		cookies = s.jar.Cookies()
 	}
+	cookies = mergeCookies(cookies, s.consentCookies)
+	if s.abuseExemption != "" {
+		cookies = append(cookies, s.abuseExemption)
+	}
+	return cookies
+}
 
-	return &Session{
-		Cities:  Map[string, string]{},
-		client:  client,
-		cookies: cookies,
-	}, nil
+// do sends req with the session cookies and stores the cookies Google sets in response.
+func (s *Session) do(req *retryablehttp.Request) (*http.Response, error) {
+	req.Header["cookie"] = s.requestCookies()
+	res, err := s.client.Do(req)
+	if err != nil {
+		return res, err
+	}
+	if s.record != nil {
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		s.record(path.Base(req.URL.Path), body)
+		res.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if s.jar == nil {
+		return res, nil
+	}
+	if cookies, err := getCookies(res); err == nil {
+		// Failing to persist the cookies must not fail the request, the jar keeps
+		// them in memory and retries saving with the next update.
+		s.jar.update(cookies)
+	}
+	return res, nil
 }
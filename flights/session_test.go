@@ -2,6 +2,8 @@ package flights
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -9,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/text/language"
 )
 
 type httpClientMock struct {
@@ -50,3 +53,58 @@ func (c *httpClientMock) Do(req *retryablehttp.Request) (retres *http.Response,
 	r, c.Responses = c.Responses[0], c.Responses[1:]
 	return r()
 }
+
+func TestCustomRetryPolicyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	retry, err := customRetryPolicy()(ctx, nil, context.Canceled)
+	if retry {
+		t.Fatal("canceled requests should not be retried")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestNewInvalidMarket(t *testing.T) {
+	for _, market := range []string{"XX", "Germany", "150"} {
+		if _, err := New(WithMarket(market)); err == nil {
+			t.Fatalf("expected an error for market %q", market)
+		}
+	}
+}
+
+func TestMarketParam(t *testing.T) {
+	if param := (&Session{}).marketParam(); param != "" {
+		t.Fatalf("expected no market parameter, got: %s", param)
+	}
+	if param := (&Session{market: "DE"}).marketParam(); param != "&gl=DE" {
+		t.Fatalf("wrong market parameter: %s", param)
+	}
+}
+
+func TestResponseRecorderMock(t *testing.T) {
+	httpClientMock, err := newHttpClientMock(t, "testdata/city_athens.resp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var endpoint string
+	var recorded []byte
+	session := &Session{client: httpClientMock}
+	WithResponseRecorder(func(e string, body []byte) {
+		endpoint, recorded = e, body
+	})(session)
+
+	// The recorded body is still parsed.
+	if _, err := session.AbbrCity(context.Background(), "Athens", language.English); err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("testdata/city_athens.resp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoint != "batchexecute" || !bytes.Equal(recorded, want) {
+		t.Fatalf("wrong recording of endpoint %q: %d bytes", endpoint, len(recorded))
+	}
+}
@@ -96,6 +96,40 @@ func (o FullOffer) String() string {
 type PriceRange struct {
 	Low  float64
 	High float64
+
+	// Insight is Google's assessment of the current prices of the search, zero if
+	// the search didn't have one.
+	Insight PriceInsight
+}
+
+// PriceLevel is Google's classification of the current prices of a search.
+type PriceLevel int64
+
+const (
+	PriceLevelUnknown PriceLevel = iota
+	PriceLevelLow
+	PriceLevelTypical
+	PriceLevelHigh
+)
+
+func (l PriceLevel) String() string {
+	switch l {
+	case PriceLevelLow:
+		return "low"
+	case PriceLevelTypical:
+		return "typical"
+	case PriceLevelHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+// PriceInsight is the "Prices are currently low" line of the "View price history"
+// subsection of a search.
+type PriceInsight struct {
+	Level    PriceLevel
+	Cheapest float64 // the cheapest price of the search
+	Usual    float64 // the price Google considers usual for the route
 }
 
 // Stops specifies how many stops the trip should contain.
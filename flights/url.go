@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/krisukox/google-flights-api/flights/internal/urlpb"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -105,5 +108,111 @@ func (s *Session) SerializeURL(ctx context.Context, args Args) (string, error) {
 	return "https://www.google.com/travel/flights/search" +
 		"?tfs=" + base64.RawURLEncoding.EncodeToString(tfs) +
 		"&curr=" + args.Currency.String() +
-		"&hl=" + args.Lang.String(), nil
+		"&hl=" + args.Lang.String() + s.marketParam(), nil
+}
+
+func deserializeLocations(locations []*urlpb.Url_Location) (cities, airports []string) {
+	for _, l := range locations {
+		if l.GetType() == urlpb.Url_AIRPORT {
+			airports = append(airports, l.GetName())
+		} else {
+			cities = append(cities, l.GetName())
+		}
+	}
+	return cities, airports
+}
+
+func deserializeTravelers(travelers []urlpb.Url_Traveler) Travelers {
+	var t Travelers
+	for _, traveler := range travelers {
+		switch traveler {
+		case urlpb.Url_ADULT:
+			t.Adults++
+		case urlpb.Url_CHILD:
+			t.Children++
+		case urlpb.Url_INFANT_IN_SEAT:
+			t.InfantInSeat++
+		case urlpb.Url_INFANT_ON_LAP:
+			t.InfantOnLap++
+		}
+	}
+	return t
+}
+
+// ParseURL is the inverse of [Session.SerializeURL]. It decodes a Google Flights search URL
+// into [Args].
+//
+// Cities are returned in the abbreviated form used by the URL (e.g. "/m/0n2z"), not as city
+// names, so only the airports of the returned Args can be passed to other Session functions
+// as they are.
+//
+// ParseURL returns an error if the URL is not a Google Flights search URL.
+func ParseURL(rawURL string) (Args, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Args{}, fmt.Errorf("parse url: %v", err)
+	}
+	query := u.Query()
+
+	tfs := query.Get("tfs")
+	if tfs == "" {
+		return Args{}, fmt.Errorf("url doesn't contain the 'tfs' parameter")
+	}
+	rawProto, err := base64.RawURLEncoding.DecodeString(tfs)
+	if err != nil {
+		return Args{}, fmt.Errorf("decode 'tfs' parameter: %v", err)
+	}
+	urlProto := &urlpb.Url{}
+	if err := proto.Unmarshal(rawProto, urlProto); err != nil {
+		return Args{}, fmt.Errorf("error during url deserialization: %s", err)
+	}
+	if len(urlProto.Flight) == 0 {
+		return Args{}, fmt.Errorf("url doesn't contain any flight")
+	}
+
+	args := Args{Options: OptionsDefault()}
+
+	outbound := urlProto.Flight[0]
+	if args.Date, err = time.Parse(time.DateOnly, outbound.GetDate()); err != nil {
+		return Args{}, fmt.Errorf("parse date: %v", err)
+	}
+	args.SrcCities, args.SrcAirports = deserializeLocations(outbound.SrcLocations)
+	args.DstCities, args.DstAirports = deserializeLocations(outbound.DstLocations)
+	if outbound.Stops != nil {
+		args.Stops = Stops(outbound.GetStops())
+	}
+
+	args.TripType = TripType(urlProto.TripType)
+	if args.TripType == 0 {
+		args.TripType = RoundTrip
+		if len(urlProto.Flight) == 1 {
+			args.TripType = OneWay
+		}
+	}
+	args.ReturnDate = args.Date
+	if args.TripType == RoundTrip && len(urlProto.Flight) > 1 {
+		if args.ReturnDate, err = time.Parse(time.DateOnly, urlProto.Flight[1].GetDate()); err != nil {
+			return Args{}, fmt.Errorf("parse return date: %v", err)
+		}
+	}
+
+	if travelers := deserializeTravelers(urlProto.Travelers); travelers != (Travelers{}) {
+		args.Travelers = travelers
+	}
+	if urlProto.Class != urlpb.Url_UNSPECIFIED_CLASS {
+		args.Class = Class(urlProto.Class)
+	}
+
+	if curr := query.Get("curr"); curr != "" {
+		if args.Currency, err = currency.ParseISO(curr); err != nil {
+			return Args{}, fmt.Errorf("parse currency: %v", err)
+		}
+	}
+	if hl := query.Get("hl"); hl != "" {
+		if args.Lang, err = language.Parse(hl); err != nil {
+			return Args{}, fmt.Errorf("parse language: %v", err)
+		}
+	}
+
+	return args, nil
 }
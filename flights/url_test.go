@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-test/deep"
 	"golang.org/x/text/currency"
 	"golang.org/x/text/language"
 )
@@ -74,3 +75,41 @@ func TestSerializeURL2(t *testing.T) {
 		t.Fatalf("wrong serialized url, expected: %v serialized: %v", expectedURL, url)
 	}
 }
+
+func TestParseURL(t *testing.T) {
+	rawURL := "https://www.google.com/travel/flights/search?tfs=GjMSCjIwMjMtMTItMTAoAmoMCAISCC9tLzA0anBsagcIARIDU0ZPcgwIAhIIL20vMGYydjAaMxIKMjAyMy0xMi0yMCgCagwIAhIIL20vMGYydjByDAgCEggvbS8wNGpwbHIHCAESA1NGT0IEAQECA0gBmAEB&curr=PLN&hl=de"
+
+	args, err := ParseURL(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	date, _ := time.Parse("2006-01-02", "2023-12-10")
+	returnDate, _ := time.Parse("2006-01-02", "2023-12-20")
+
+	expected := Args{
+		date,
+		returnDate,
+		[]string{"/m/04jpl"},
+		[]string{"SFO"},
+		[]string{"/m/0f2v0"},
+		nil,
+		Options{Travelers{Adults: 2, Children: 1, InfantOnLap: 1}, currency.PLN, Stop2, Economy, RoundTrip, language.German},
+	}
+
+	if diff := deep.Equal(args, expected); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestParseURLInvalid(t *testing.T) {
+	for _, rawURL := range []string{
+		"https://www.google.com/travel/flights/search",
+		"https://www.google.com/travel/flights/search?tfs=%%%",
+		"https://www.google.com/travel/flights/search?tfs=AAAA",
+	} {
+		if _, err := ParseURL(rawURL); err == nil {
+			t.Errorf("expected error for %s", rawURL)
+		}
+	}
+}
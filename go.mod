@@ -8,13 +8,15 @@ require (
 	github.com/anyascii/go v0.3.2
 	github.com/browserutils/kooky v0.2.1-0.20240119192416-d4f81abd0200
 	github.com/go-test/deep v1.1.0
+	github.com/google/jsonschema-go v0.3.0
 	github.com/hashicorp/go-retryablehttp v0.7.4
+	github.com/lib/pq v1.10.9
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/modelcontextprotocol/go-sdk v1.0.0
 	google.golang.org/protobuf v1.31.0
 )
 
 require (
-	github.com/google/jsonschema-go v0.3.0 // indirect
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
 	github.com/yosida95/uritemplate/v3 v3.0.2 // indirect
 )
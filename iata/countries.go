@@ -0,0 +1,48 @@
+package iata
+
+import (
+	"sort"
+	"strings"
+)
+
+// Country returns the ISO 3166-1 alpha-2 code of the country an airport is located
+// in, ignoring case, "" if the airport code isn't supported. See
+// iata/generate/airports for where the countries come from.
+func Country(iata string) string {
+	iata = strings.ToUpper(iata)
+	i := sort.Search(len(airports), func(i int) bool { return airports[i].Code >= iata })
+	if i == len(airports) || airports[i].Code != iata {
+		return ""
+	}
+	return airports[i].Country
+}
+
+// CountryName returns the English name of an ISO 3166-1 alpha-2 country code, ""
+// if the code is unknown.
+func CountryName(code string) string {
+	return countryNames[code]
+}
+
+// ParseCountry resolves an ISO 3166-1 alpha-2 code or an English country name,
+// ignoring case, to the country code. Names may leave out their parenthesized
+// part, e.g. "Korea" for "Korea (South)" if that is unambiguous.
+func ParseCountry(query string) (string, bool) {
+	query = strings.TrimSpace(query)
+	if code := strings.ToUpper(query); countryNames[code] != "" {
+		return code, true
+	}
+	var found string
+	for code, name := range countryNames {
+		if strings.EqualFold(name, query) {
+			return code, true
+		}
+		short, _, ok := strings.Cut(name, " (")
+		if ok && strings.EqualFold(short, query) {
+			if found != "" {
+				return "", false
+			}
+			found = code
+		}
+	}
+	return found, found != ""
+}
@@ -0,0 +1,52 @@
+package iata
+
+import "testing"
+
+func TestCountry(t *testing.T) {
+	for code, expected := range map[string]string{
+		"FRA": "DE",
+		"jfk": "US",
+		// Airports given the time zone of a neighbouring country.
+		"GVA": "CH",
+		"SZG": "AT",
+		"HAN": "VN",
+		"TIJ": "MX",
+		"XSP": "SG",
+		// The EuroAirport is in France, though it also serves Basel.
+		"BSL": "FR",
+		"XXQ": "",
+		"":    "",
+	} {
+		if country := Country(code); country != expected {
+			t.Errorf("Country(%q) = %q, expected %q", code, country, expected)
+		}
+	}
+
+	for _, a := range Airports() {
+		if CountryName(a.Country) == "" {
+			t.Fatalf("%s: unknown country %q", a.Code, a.Country)
+		}
+	}
+}
+
+func TestParseCountry(t *testing.T) {
+	for query, expected := range map[string]string{
+		"ch":           "CH",
+		" Germany ":    "DE",
+		"switzerland":  "CH",
+		"Britain":      "GB",
+		"Britain (UK)": "GB",
+		"Myanmar":      "MM",
+		// Left out parenthesized parts that leave a name shared by several countries.
+		"Korea":   "",
+		"Congo":   "",
+		"Samoa":   "",
+		"XX":      "",
+		"Nowhere": "",
+	} {
+		code, ok := ParseCountry(query)
+		if code != expected || ok != (expected != "") {
+			t.Errorf("ParseCountry(%q) = %q, %v, expected %q", query, code, ok, expected)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Command airports generates iata/airports_gen.go, the list of the supported
+// airports sorted by code, from the switch of IATATimeZone in iata/iata.go. The
+// country of an airport is the one the zone.tab file of the tz database names for
+// its time zone, unless countryOverrides says otherwise. Run it whenever
+// iata/iata.go is regenerated.
+//
+// Command: go run ./iata/generate/airports -zoneinfo /usr/share/zoneinfo
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type airport struct {
+	code, country, city, tz string
+}
+
+// legacyZones are zone names used by the airport list that the tz database has
+// since renamed or merged, by their current name.
+var legacyZones = map[string]string{
+	"America/Nipigon":     "America/Toronto",
+	"America/Pangnirtung": "America/Iqaluit",
+	"America/Rainy_River": "America/Winnipeg",
+	"America/Thunder_Bay": "America/Toronto",
+	"America/Yellowknife": "America/Edmonton",
+	"Australia/Currie":    "Australia/Hobart",
+	"Europe/Kiev":         "Europe/Kyiv",
+}
+
+// countryOverrides are the ISO 3166-1 alpha-2 codes of the airports the airport
+// list gives the time zone of a neighbouring country, mostly airports near a
+// border.
+var countryOverrides = map[string]string{
+	"ACH": "CH", // Altenrhein, Europe/Vienna
+	"ARI": "CL", // Arica, America/Lima
+	"DIN": "VN", // Dien Bien Phu, Asia/Bangkok
+	"GOM": "CD", // Goma, Africa/Kigali
+	"GVA": "CH", // Geneva, Europe/Paris
+	"HAN": "VN", // Hanoi, Asia/Bangkok
+	"HPH": "VN", // Haiphong, Asia/Bangkok
+	"IGU": "BR", // Foz do Iguacu, America/Argentina/Cordoba
+	"IXA": "IN", // Agartala, Asia/Dhaka
+	"JEK": "ZM", // Lower Zambezi National Park, Africa/Harare
+	"LMN": "MY", // Limbang, Asia/Brunei
+	"MLN": "ES", // Melilla, Africa/Casablanca
+	"NDB": "MR", // Nouadhibou, Africa/El_Aaiun
+	"NRN": "DE", // Weeze, Europe/Amsterdam
+	"OMD": "NA", // Oranjemund, Africa/Johannesburg
+	"OUI": "LA", // Ban Houei Sai, Asia/Bangkok
+	"PMG": "BR", // Ponta Pora, America/Asuncion
+	"SFG": "MF", // Grand Case, America/Lower_Princes
+	"SZG": "AT", // Salzburg, Europe/Berlin
+	"TBT": "BR", // Tabatinga, America/Bogota
+	"THD": "VN", // Thanh Hoa, Asia/Bangkok
+	"TIJ": "MX", // Tijuana, America/Los_Angeles
+	"VDH": "VN", // Dong Hoi, Asia/Bangkok
+	"VII": "VN", // Vinh, Asia/Bangkok
+	"WPU": "CL", // Puerto Williams, America/Argentina/Ushuaia
+	"XSP": "SG", // Seletar, Asia/Kuala_Lumpur
+	"YAM": "CA", // Sault Ste. Marie, America/Detroit
+	"YXX": "CA", // Abbotsford, America/Los_Angeles
+	"ZVK": "LA", // Savannakhet, Asia/Bangkok
+}
+
+// readZoneCountries maps the time zones of the zone.tab file at path to the ISO
+// 3166-1 alpha-2 code of their country.
+func readZoneCountries(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	countries := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("%s: malformed line %q", path, line)
+		}
+		countries[fields[2]] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for legacy, current := range legacyZones {
+		country, ok := countries[current]
+		if !ok {
+			return nil, fmt.Errorf("legacy zone %s: unknown zone %s", legacy, current)
+		}
+		countries[legacy] = country
+	}
+	return countries, nil
+}
+
+// setCountries sets the countries of airports from their time zones and
+// countryOverrides.
+func setCountries(airports []airport, zoneCountries map[string]string) error {
+	overridden := 0
+	for i, a := range airports {
+		country, ok := zoneCountries[a.tz]
+		if !ok {
+			return fmt.Errorf("%s: no country for time zone %s", a.code, a.tz)
+		}
+		if override, ok := countryOverrides[a.code]; ok {
+			if override == country {
+				return fmt.Errorf("%s: the override equals the country of %s", a.code, a.tz)
+			}
+			country = override
+			overridden++
+		}
+		airports[i].country = country
+	}
+	if overridden != len(countryOverrides) {
+		return fmt.Errorf("%d country overrides name unsupported airports", len(countryOverrides)-overridden)
+	}
+	return nil
+}
+
+// readAirports returns the airports of the cases of the IATATimeZone switch in the
+// file at path.
+func readAirports(path string) ([]airport, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var airports []airport
+	var inspectErr error
+	ast.Inspect(file, func(n ast.Node) bool {
+		clause, ok := n.(*ast.CaseClause)
+		if !ok || inspectErr != nil {
+			return inspectErr == nil
+		}
+		if len(clause.List) != 1 || len(clause.Body) != 1 {
+			inspectErr = fmt.Errorf("unexpected case clause at offset %d", clause.Pos())
+			return false
+		}
+		code, err := stringLit(clause.List[0])
+		if err != nil {
+			inspectErr = err
+			return false
+		}
+		ret, ok := clause.Body[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			inspectErr = fmt.Errorf("case %s: expected a return statement", code)
+			return false
+		}
+		lit, ok := ret.Results[0].(*ast.CompositeLit)
+		if !ok || len(lit.Elts) != 2 {
+			inspectErr = fmt.Errorf("case %s: expected a Location literal", code)
+			return false
+		}
+		a := airport{code: code}
+		if a.city, err = stringLit(lit.Elts[0]); err == nil {
+			a.tz, err = stringLit(lit.Elts[1])
+		}
+		if err != nil {
+			inspectErr = fmt.Errorf("case %s: %v", code, err)
+			return false
+		}
+		airports = append(airports, a)
+		return false
+	})
+	if inspectErr != nil {
+		return nil, inspectErr
+	}
+	if len(airports) == 0 {
+		return nil, fmt.Errorf("%s: no airports found", path)
+	}
+	sort.Slice(airports, func(i, j int) bool { return airports[i].code < airports[j].code })
+	return airports, nil
+}
+
+func stringLit(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("expected a string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+func main() {
+	in := flag.String("in", "iata/iata.go", "generated file of IATATimeZone")
+	zoneinfo := flag.String("zoneinfo", "/usr/share/zoneinfo", "directory of the tz database")
+	out := flag.String("out", "iata/airports_gen.go", "file to write")
+	flag.Parse()
+
+	airports, err := readAirports(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	zoneCountries, err := readZoneCountries(filepath.Join(*zoneinfo, "zone.tab"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := setCountries(airports, zoneCountries); err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go run ./iata/generate/airports; DO NOT EDIT.\n\npackage iata\n\n")
+	buf.WriteString("// airports are the supported airports, sorted by code.\nvar airports = []Airport{\n")
+	for _, a := range airports {
+		fmt.Fprintf(&buf, "\t{%q, %q, Location{%q, %q}},\n", a.code, a.country, a.city, a.tz)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+// Command countries generates iata/countries_gen.go, which maps the ISO 3166-1
+// alpha-2 country codes to the English country names, from the iso3166.tab file of
+// the tz database. The countries of the airports are generated by
+// iata/generate/airports.
+//
+// Command: go run ./iata/generate/countries -zoneinfo /usr/share/zoneinfo
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// readTab maps the tab-separated column key to the column value of every line of a
+// tz database table.
+func readTab(path string, key, value int) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) <= max(key, value) {
+			return nil, fmt.Errorf("%s: malformed line %q", path, line)
+		}
+		entries[fields[key]] = fields[value]
+	}
+	return entries, scanner.Err()
+}
+
+func writeMap(buf *bytes.Buffer, name, doc string, entries map[string]string) {
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(buf, "// %s\nvar %s = map[string]string{\n", doc, name)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "\t%q: %q,\n", k, entries[k])
+	}
+	buf.WriteString("}\n\n")
+}
+
+func main() {
+	zoneinfo := flag.String("zoneinfo", "/usr/share/zoneinfo", "directory of the tz database")
+	out := flag.String("out", "iata/countries_gen.go", "file to write")
+	flag.Parse()
+
+	countryNames, err := readTab(filepath.Join(*zoneinfo, "iso3166.tab"), 0, 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go run ./iata/generate/countries; DO NOT EDIT.\n\npackage iata\n\n")
+	writeMap(&buf, "countryNames", "countryNames maps ISO 3166-1 alpha-2 codes to the English country names.", countryNames)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,258 @@
+// Command names generates iata/names_gen.go, the localized names of the cities of
+// the airports, from the exemplar cities the Unicode CLDR has for the time zones.
+// An airport gets the names of the exemplar city of its time zone if the airport
+// list names its city like that exemplar city in one of the languages, e.g. FCO in
+// Rome gets those of Europe/Rome. cityOverrides adds the names of the cities that
+// aren't the exemplar city of a time zone. Run it whenever iata/iata.go is
+// regenerated.
+//
+// Command: go run ./iata/generate/names -cldr cldr-common-43.0.zip
+//
+// The archives of the CLDR releases are at https://unicode.org/Public/cldr/.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"cmp"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/anyascii/go"
+	"github.com/krisukox/google-flights-api/iata"
+	"golang.org/x/text/unicode/cldr"
+)
+
+// cityOverrides are names of cities by airport code and base language that the
+// CLDR doesn't have, because the cities aren't the exemplar city of their time
+// zone. "en" corrects the English names the airport list has in the local
+// language, e.g. Venezia.
+var cityOverrides = map[string]map[string]string{
+	"BCN": {"fr": "Barcelone", "it": "Barcellona"},
+	"BEG": {"en": "Belgrade"},
+	"BOD": {"en": "Bordeaux", "es": "Burdeos"},
+	"BSL": {"en": "Basel/Mulhouse", "de": "Basel/Mülhausen", "fr": "Bâle/Mulhouse", "es": "Basilea/Mulhouse", "it": "Basilea/Mulhouse"},
+	"CGN": {"de": "Köln", "fr": "Cologne", "es": "Colonia", "it": "Colonia"},
+	"CIA": {"en": "Rome"},
+	"CPT": {"de": "Kapstadt", "fr": "Le Cap", "es": "Ciudad del Cabo", "it": "Città del Capo"},
+	"DUS": {"en": "Düsseldorf"},
+	"EDI": {"fr": "Édimbourg", "es": "Edimburgo", "it": "Edimburgo"},
+	"FLR": {"en": "Florence", "de": "Florenz", "es": "Florencia", "it": "Firenze"},
+	"FRA": {"en": "Frankfurt", "de": "Frankfurt am Main", "fr": "Francfort-sur-le-Main", "es": "Fráncfort del Meno", "it": "Francoforte sul Meno"},
+	"GDN": {"en": "Gdańsk", "de": "Danzig", "it": "Danzica"},
+	"GOT": {"de": "Göteborg", "fr": "Göteborg", "es": "Gotemburgo", "it": "Göteborg"},
+	"GVA": {"de": "Genf", "fr": "Genève", "es": "Ginebra", "it": "Ginevra"},
+	"HAJ": {"fr": "Hanovre"},
+	"HAM": {"fr": "Hambourg", "es": "Hamburgo", "it": "Amburgo"},
+	"IST": {"en": "Istanbul", "es": "Estambul"},
+	"KRK": {"en": "Kraków", "de": "Krakau", "fr": "Cracovie", "es": "Cracovia", "it": "Cracovia"},
+	"LED": {"de": "Sankt Petersburg", "fr": "Saint-Pétersbourg", "es": "San Petersburgo", "it": "San Pietroburgo"},
+	"MRS": {"es": "Marsella", "it": "Marsiglia"},
+	"MUC": {"de": "München", "fr": "Munich", "es": "Múnich", "it": "Monaco di Baviera"},
+	"NAP": {"en": "Naples", "de": "Neapel", "fr": "Naples", "es": "Nápoles", "it": "Napoli"},
+	"NCE": {"de": "Nizza", "es": "Niza", "it": "Nizza"},
+	"NUE": {"de": "Nürnberg", "fr": "Nuremberg", "es": "Núremberg", "it": "Norimberga"},
+	"PEK": {"de": "Peking", "fr": "Pékin", "es": "Pekín", "it": "Pechino"},
+	"SKG": {"fr": "Thessalonique", "es": "Tesalónica", "it": "Salonicco"},
+	"SVQ": {"en": "Seville", "de": "Sevilla", "fr": "Séville", "it": "Siviglia"},
+	"TLS": {"en": "Toulouse", "it": "Tolosa"},
+	"VCE": {"en": "Venice", "de": "Venedig", "fr": "Venise", "es": "Venecia", "it": "Venezia"},
+	"WRO": {"en": "Wrocław", "de": "Breslau", "es": "Breslavia", "it": "Breslavia"},
+}
+
+// readCLDR decodes the time zone names of the CLDR archive or directory at path.
+func readCLDR(path string) (*cldr.CLDR, error) {
+	d := &cldr.Decoder{}
+	d.SetDirFilter("main")
+	d.SetSectionFilter("dates")
+	if filepath.Ext(path) != ".zip" {
+		return d.DecodePath(path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return d.DecodeZip(f)
+}
+
+// canonicalZones maps the aliases of the time zones to the names the CLDR uses,
+// e.g. Asia/Kolkata to Asia/Calcutta, from common/bcp47/timezone.xml of the CLDR
+// archive or directory at path, which the cldr package doesn't decode.
+func canonicalZones(path string) (map[string]string, error) {
+	var fsys fs.FS = os.DirFS(path)
+	if filepath.Ext(path) == ".zip" {
+		archive, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		defer archive.Close()
+		fsys = archive
+	}
+	f, err := fsys.Open("common/bcp47/timezone.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var doc struct {
+		Types []struct {
+			Alias string `xml:"alias,attr"`
+		} `xml:"keyword>key>type"`
+	}
+	if err := xml.NewDecoder(f).Decode(&doc); err != nil {
+		return nil, err
+	}
+	zones := map[string]string{}
+	for _, t := range doc.Types {
+		names := strings.Fields(t.Alias)
+		for _, name := range names {
+			zones[name] = names[0]
+		}
+	}
+	return zones, nil
+}
+
+// exemplarCities maps the time zones to the approved names of their exemplar
+// cities in locale.
+func exemplarCities(data *cldr.CLDR, locale string) (map[string]string, error) {
+	ldml := data.RawLDML(locale)
+	if ldml == nil {
+		return nil, fmt.Errorf("no locale %s in the CLDR", locale)
+	}
+	cities := map[string]string{}
+	if ldml.Dates == nil || ldml.Dates.TimeZoneNames == nil {
+		return cities, nil
+	}
+	for _, zone := range ldml.Dates.TimeZoneNames.Zone {
+		for _, city := range zone.ExemplarCity {
+			if city.Alt == "" && city.Draft != "unconfirmed" && city.Draft != "provisional" {
+				cities[zone.Type] = city.Data()
+			}
+		}
+	}
+	return cities, nil
+}
+
+// fold returns name for comparisons that ignore case and diacritics.
+func fold(name string) string {
+	return strings.ToLower(anyascii.Transliterate(name))
+}
+
+func main() {
+	cldrPath := flag.String("cldr", "", "CLDR archive (cldr-common-*.zip) or its extracted directory")
+	langs := flag.String("languages", "de,fr,es,it", "comma-separated base languages besides English")
+	out := flag.String("out", "iata/names_gen.go", "file to write")
+	flag.Parse()
+	if *cldrPath == "" {
+		log.Fatal("-cldr is required")
+	}
+
+	data, err := readCLDR(*cldrPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	zones, err := canonicalZones(*cldrPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	languages := strings.Split(*langs, ",")
+	cities := map[string]map[string]string{}
+	for _, locale := range append([]string{"root", "en"}, languages...) {
+		if cities[locale], err = exemplarCities(data, locale); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	names := map[string]map[string]string{}
+	for _, a := range iata.Airports() {
+		if a.City == "" {
+			continue
+		}
+		zone := cmp.Or(zones[a.Tz], a.Tz)
+		// Names derived from the time zone, e.g. Easter of Pacific/Easter, only
+		// identify the city, they aren't always its English name.
+		cldrEnglish := cmp.Or(cities["en"][zone], cities["root"][zone])
+		english := cmp.Or(cldrEnglish, strings.ReplaceAll(path.Base(zone), "_", " "))
+		candidates := []string{fold(english), fold(strings.ReplaceAll(path.Base(a.Tz), "_", " "))}
+		for _, lang := range languages {
+			if name := cities[lang][zone]; name != "" {
+				candidates = append(candidates, fold(name))
+			}
+		}
+		if !slices.Contains(candidates, fold(a.City)) {
+			continue
+		}
+
+		city := map[string]string{}
+		if cldrEnglish != "" && cldrEnglish != a.City {
+			city["en"] = cldrEnglish
+		}
+		for _, lang := range languages {
+			if name := cities[lang][zone]; name != "" && name != english {
+				city[lang] = name
+			}
+		}
+		if len(city) > 0 {
+			names[a.Code] = city
+		}
+	}
+
+	for code, overrides := range cityOverrides {
+		if !iata.Supported(code) {
+			log.Fatalf("%s: override of an unsupported airport", code)
+		}
+		for lang, name := range overrides {
+			if lang != "en" && !slices.Contains(languages, lang) {
+				log.Fatalf("%s: override in %s, which isn't generated", code, lang)
+			}
+			if names[code][lang] == name {
+				log.Fatalf("%s: the override in %s repeats the name %q of the CLDR", code, lang, name)
+			}
+			if names[code] == nil {
+				names[code] = map[string]string{}
+			}
+			names[code][lang] = name
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go run ./iata/generate/names from %s; DO NOT EDIT.\n\npackage iata\n\n", filepath.Base(*cldrPath))
+	buf.WriteString(`// cityNames holds the names of the cities of the airports by airport code and base
+// language where they differ from the English name, which "en" holds where it
+// differs from the one of the airport list.
+var cityNames = map[string]map[string]string{
+`)
+	codes := make([]string, 0, len(names))
+	for code := range names {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	for _, code := range codes {
+		var entries []string
+		for _, lang := range append([]string{"en"}, languages...) {
+			if name, ok := names[code][lang]; ok {
+				entries = append(entries, fmt.Sprintf("%q: %q", lang, name))
+			}
+		}
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", code, strings.Join(entries, ", "))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
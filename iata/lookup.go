@@ -0,0 +1,71 @@
+package iata
+
+import (
+	"sort"
+	"strings"
+)
+
+// Airport is an IATA airport code supported by the Google Flights API.
+type Airport struct {
+	Code    string
+	Country string // ISO 3166-1 alpha-2 code
+	Location
+}
+
+// Airports returns all supported IATA airport codes, sorted by code. The list is
+// generated from IATATimeZone by iata/generate/airports.
+func Airports() []Airport {
+	return airports
+}
+
+const notSupported = "Not supported IATA Code"
+
+// Supported reports whether the IATA airport code is supported by the Google Flights
+// API, ignoring case.
+func Supported(code string) bool {
+	return IATATimeZone(strings.ToUpper(code)).Tz != notSupported
+}
+
+// Lookup returns the airports whose code equals the query or whose city contains it,
+// ignoring case. Exact code matches come first, then cities starting with the query,
+// then the remaining matches, each sorted by city and code.
+func Lookup(query string) []Airport {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil
+	}
+	upper := strings.ToUpper(query)
+	lower := strings.ToLower(query)
+
+	rank := func(a Airport) int {
+		city := strings.ToLower(a.City)
+		switch {
+		case a.Code == upper:
+			return 0
+		case city == lower:
+			return 1
+		case strings.HasPrefix(city, lower):
+			return 2
+		case strings.Contains(city, lower):
+			return 3
+		}
+		return -1
+	}
+
+	var matches []Airport
+	for _, a := range Airports() {
+		if rank(a) >= 0 {
+			matches = append(matches, a)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if ri, rj := rank(matches[i]), rank(matches[j]); ri != rj {
+			return ri < rj
+		}
+		if matches[i].City != matches[j].City {
+			return matches[i].City < matches[j].City
+		}
+		return matches[i].Code < matches[j].Code
+	})
+	return matches
+}
@@ -0,0 +1,57 @@
+package iata
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestAirports(t *testing.T) {
+	airports := Airports()
+	if len(airports) == 0 {
+		t.Fatal("expected airports")
+	}
+	if !sort.SliceIsSorted(airports, func(i, j int) bool { return airports[i].Code < airports[j].Code }) {
+		t.Fatal("expected the airports to be sorted by code")
+	}
+	for i, a := range airports {
+		if i > 0 && airports[i-1].Code == a.Code {
+			t.Fatalf("%s is listed twice", a.Code)
+		}
+		// The generated list must agree with IATATimeZone, see iata/generate/airports.
+		if loc := IATATimeZone(a.Code); loc != a.Location {
+			t.Fatalf("%s: listed as %+v, IATATimeZone returns %+v", a.Code, a.Location, loc)
+		}
+	}
+}
+
+func TestSupported(t *testing.T) {
+	for code, expected := range map[string]bool{
+		"FRA":  true,
+		"fra":  true,
+		"Jfk":  true,
+		"XXQ":  false,
+		"":     false,
+		"FRAX": false,
+	} {
+		if Supported(code) != expected {
+			t.Errorf("Supported(%q) = %v, expected %v", code, !expected, expected)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	matches := Lookup(" jfk ")
+	if len(matches) == 0 || matches[0].Code != "JFK" {
+		t.Fatalf("expected JFK first, got %+v", matches)
+	}
+
+	// Cities equal to the query come first, their airports sorted by code.
+	matches = Lookup("new york")
+	if len(matches) < 2 || matches[0].City != "New York" || matches[1].City != "New York" || matches[0].Code > matches[1].Code {
+		t.Fatalf("expected the New York airports first, got %+v", matches)
+	}
+
+	if matches := Lookup("  "); matches != nil {
+		t.Fatalf("expected no matches for a blank query, got %+v", matches)
+	}
+}
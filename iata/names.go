@@ -0,0 +1,61 @@
+package iata
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// CityName returns the name of the city of an airport in lang, e.g. "München" for
+// MUC in German. Cities without a name in lang, and languages without names,
+// fall back to the English name, which is "" for the airports without a city and
+// for unsupported codes.
+func CityName(iata string, lang language.Tag) string {
+	base, _ := lang.Base()
+	if name := cityNames[iata][base.String()]; name != "" {
+		return name
+	}
+	if name := cityNames[iata]["en"]; name != "" {
+		return name
+	}
+	if !Supported(iata) {
+		return ""
+	}
+	return IATATimeZone(iata).City
+}
+
+// TranslateCity returns the name in lang of the city name stands for in any
+// language of the name table or the airport list, ignoring case, e.g. "Cologne"
+// for "Köln" in English. It reports false for names of no city of the table and
+// for names of several cities with different names in lang.
+func TranslateCity(name string, lang language.Tag) (string, bool) {
+	var translated string
+	for _, code := range cityCodes()[strings.ToLower(strings.TrimSpace(name))] {
+		n := CityName(code, lang)
+		if translated != "" && n != translated {
+			return "", false
+		}
+		translated = n
+	}
+	return translated, translated != ""
+}
+
+// cityCodes maps the lower-cased names of the cities of cityNames to their airport
+// codes.
+var cityCodes = sync.OnceValue(func() map[string][]string {
+	codes := map[string][]string{}
+	add := func(name, code string) {
+		key := strings.ToLower(name)
+		if name != "" && (len(codes[key]) == 0 || codes[key][len(codes[key])-1] != code) {
+			codes[key] = append(codes[key], code)
+		}
+	}
+	for code, names := range cityNames {
+		add(IATATimeZone(code).City, code)
+		for _, name := range names {
+			add(name, code)
+		}
+	}
+	return codes
+})
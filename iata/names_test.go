@@ -0,0 +1,53 @@
+package iata
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestTranslateCity(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		lang     language.Tag
+		expected string
+	}{
+		{"Köln", language.English, "Cologne"},
+		{" köln ", language.Italian, "Colonia"},
+		{"Venezia", language.English, "Venice"},
+		{"Munich", language.German, "München"},
+		{"München", language.German, "München"},
+		// London has several airports, all named alike.
+		{"Londres", language.English, "London"},
+		{"Atlantis", language.English, ""},
+	} {
+		name, ok := TranslateCity(tc.name, tc.lang)
+		if name != tc.expected || ok != (tc.expected != "") {
+			t.Errorf("TranslateCity(%q, %s) = %q, %v, expected %q", tc.name, tc.lang, name, ok, tc.expected)
+		}
+	}
+}
+
+func TestCityName(t *testing.T) {
+	for _, tc := range []struct {
+		code     string
+		lang     language.Tag
+		expected string
+	}{
+		// Exemplar cities of the CLDR.
+		{"LIS", language.German, "Lissabon"},
+		{"CCU", language.Spanish, "Calcuta"},
+		{"KBP", language.English, "Kyiv"},
+		// Overrides of cities that aren't the exemplar city of their time zone.
+		{"MUC", language.German, "München"},
+		{"CIA", language.English, "Rome"},
+		// Languages without names fall back to English.
+		{"VCE", language.Japanese, "Venice"},
+		{"SFO", language.German, "San Francisco"},
+		{"XXQ", language.English, ""},
+	} {
+		if name := CityName(tc.code, tc.lang); name != tc.expected {
+			t.Errorf("CityName(%q, %s) = %q, expected %q", tc.code, tc.lang, name, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package cheapoffers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// airlineConcurrency limits the number of parallel GetOffers requests issued by CheapestByAirline.
+const airlineConcurrency = 4
+
+// AirlineArgs describes the route and departure window for [CheapestByAirline].
+type AirlineArgs struct {
+	RangeStartDate time.Time
+	RangeEndDate   time.Time
+	TripLength     int
+	SrcCities      []string
+	DstCities      []string
+	Options        flights.Options
+}
+
+// AirlinePrice is the cheapest itinerary found for a single marketing carrier.
+type AirlinePrice struct {
+	AirlineCode string // IATA code of the carrier, taken from the flight number
+	AirlineName string
+	Price       float64
+	StartDate   time.Time
+	ReturnDate  time.Time
+	SrcAirport  string
+	DstAirport  string
+	Stops       int
+}
+
+// CheapestByAirline searches every departure date of the window and returns the cheapest
+// itinerary per marketing carrier, sorted by price. An itinerary is attributed to the
+// carrier of its first flight.
+func CheapestByAirline(ctx context.Context, session Session, args AirlineArgs) ([]AirlinePrice, error) {
+	if err := validateAirlineArgs(args); err != nil {
+		return nil, err
+	}
+
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		cheapest = map[string]AirlinePrice{}
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, airlineConcurrency)
+
+	for date := args.RangeStartDate; !date.After(args.RangeEndDate); date = date.AddDate(0, 0, 1) {
+		startDate := date
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctxWithCancel.Done():
+				return
+			}
+
+			fullOffers, _, err := session.GetOffers(
+				ctxWithCancel,
+				flights.Args{
+					Date:       startDate,
+					ReturnDate: startDate.AddDate(0, 0, args.TripLength),
+					SrcCities:  args.SrcCities,
+					DstCities:  args.DstCities,
+					Options:    args.Options,
+				},
+			)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for _, offer := range fullOffers {
+				if offer.Price == 0 || len(offer.Flight) == 0 {
+					continue
+				}
+				code := airlineCode(offer.Flight[0].FlightNumber)
+				if current, ok := cheapest[code]; ok && current.Price <= offer.Price {
+					continue
+				}
+				cheapest[code] = AirlinePrice{
+					AirlineCode: code,
+					AirlineName: offer.Flight[0].AirlineName,
+					Price:       offer.Price,
+					StartDate:   offer.StartDate,
+					ReturnDate:  offer.ReturnDate,
+					SrcAirport:  offer.SrcAirportCode,
+					DstAirport:  offer.DstAirportCode,
+					Stops:       len(offer.Flight) - 1,
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	prices := make([]AirlinePrice, 0, len(cheapest))
+	for _, p := range cheapest {
+		prices = append(prices, p)
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].Price == prices[j].Price {
+			return prices[i].AirlineCode < prices[j].AirlineCode
+		}
+		return prices[i].Price < prices[j].Price
+	})
+	return prices, nil
+}
+
+// airlineCode extracts the carrier code from a flight number such as "LH 1234".
+func airlineCode(flightNumber string) string {
+	code, _, _ := strings.Cut(strings.TrimSpace(flightNumber), " ")
+	return code
+}
+
+func validateAirlineArgs(args AirlineArgs) error {
+	if args.TripLength <= 0 {
+		return fmt.Errorf("trip length must be positive")
+	}
+	if args.RangeEndDate.Before(args.RangeStartDate) {
+		return fmt.Errorf("rangeEndDate must be on or after rangeStartDate")
+	}
+	if days := int(args.RangeEndDate.Sub(args.RangeStartDate).Hours() / 24); days > 30 {
+		return fmt.Errorf("the departure window can span at most 31 days, got %d", days+1)
+	}
+	if len(args.SrcCities) == 0 {
+		return fmt.Errorf("at least one source city is required")
+	}
+	if len(args.DstCities) == 0 {
+		return fmt.Errorf("at least one destination city is required")
+	}
+	return nil
+}
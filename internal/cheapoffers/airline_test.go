@@ -0,0 +1,121 @@
+package cheapoffers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestAirlineCode(t *testing.T) {
+	for _, tc := range []struct {
+		flightNumber string
+		expected     string
+	}{
+		{"LH 1234", "LH"},
+		{" U2 5 ", "U2"},
+		{"LH", "LH"},
+		{"", ""},
+	} {
+		if code := airlineCode(tc.flightNumber); code != tc.expected {
+			t.Errorf("airlineCode(%q) = %q, expected %q", tc.flightNumber, code, tc.expected)
+		}
+	}
+}
+
+func airlineArgs() AirlineArgs {
+	start := time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC)
+	return AirlineArgs{
+		RangeStartDate: start,
+		RangeEndDate:   start.AddDate(0, 0, 1),
+		TripLength:     7,
+		SrcCities:      []string{"Berlin"},
+		DstCities:      []string{"Rome"},
+		Options:        flights.OptionsDefault(),
+	}
+}
+
+// airlineOffer is an offer of the flights with the given flight numbers.
+func airlineOffer(date time.Time, price float64, flightNumbers ...string) flights.FullOffer {
+	offer := flights.FullOffer{
+		Offer:          flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: price},
+		SrcAirportCode: "BER",
+		DstAirportCode: "FCO",
+	}
+	for _, n := range flightNumbers {
+		offer.Flight = append(offer.Flight, flights.Flight{FlightNumber: n, AirlineName: "Airline " + airlineCode(n)})
+	}
+	return offer
+}
+
+func TestCheapestByAirline(t *testing.T) {
+	args := airlineArgs()
+	day1, day2 := args.RangeStartDate, args.RangeEndDate
+	offers := map[time.Time][]flights.FullOffer{
+		day1: {
+			airlineOffer(day1, 200, "LH 1", "LH 2"),
+			airlineOffer(day1, 150, "AZ 7"),
+			airlineOffer(day1, 0, "FR 9"), // no price
+			airlineOffer(day1, 50),        // no flights
+		},
+		day2: {
+			airlineOffer(day2, 120, "LH 3"),
+			airlineOffer(day2, 150, "FR 8", "AZ 9"), // ties with AZ, attributed to its first flight
+		},
+	}
+	session := &fakeSession{
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			return offers[args.Date], nil, nil
+		},
+	}
+
+	prices, err := CheapestByAirline(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []AirlinePrice{
+		{AirlineCode: "LH", AirlineName: "Airline LH", Price: 120, StartDate: day2, ReturnDate: day2.AddDate(0, 0, 7), SrcAirport: "BER", DstAirport: "FCO"},
+		{AirlineCode: "AZ", AirlineName: "Airline AZ", Price: 150, StartDate: day1, ReturnDate: day1.AddDate(0, 0, 7), SrcAirport: "BER", DstAirport: "FCO"},
+		{AirlineCode: "FR", AirlineName: "Airline FR", Price: 150, StartDate: day2, ReturnDate: day2.AddDate(0, 0, 7), SrcAirport: "BER", DstAirport: "FCO", Stops: 1},
+	}
+	if !reflect.DeepEqual(prices, expected) {
+		t.Fatalf("unexpected prices:\n got %+v\nwant %+v", prices, expected)
+	}
+}
+
+func TestCheapestByAirlineError(t *testing.T) {
+	failure := errors.New("upstream failure")
+	session := &fakeSession{
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			return nil, nil, failure
+		},
+	}
+	if _, err := CheapestByAirline(context.Background(), session, airlineArgs()); !errors.Is(err, failure) {
+		t.Fatalf("expected the upstream error, got %v", err)
+	}
+}
+
+func TestValidateAirlineArgs(t *testing.T) {
+	for name, modify := range map[string]func(*AirlineArgs){
+		"non-positive trip length": func(a *AirlineArgs) { a.TripLength = 0 },
+		"reversed window":          func(a *AirlineArgs) { a.RangeEndDate = a.RangeStartDate.AddDate(0, 0, -1) },
+		"window over 31 days":      func(a *AirlineArgs) { a.RangeEndDate = a.RangeStartDate.AddDate(0, 0, 31) },
+		"no source city":           func(a *AirlineArgs) { a.SrcCities = nil },
+		"no destination city":      func(a *AirlineArgs) { a.DstCities = nil },
+	} {
+		args := airlineArgs()
+		modify(&args)
+		if err := validateAirlineArgs(args); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	args := airlineArgs()
+	args.RangeEndDate = args.RangeStartDate.AddDate(0, 0, 30)
+	if err := validateAirlineArgs(args); err != nil {
+		t.Fatalf("expected a 31-day window to be valid, got %v", err)
+	}
+}
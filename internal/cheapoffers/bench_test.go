@@ -0,0 +1,145 @@
+package cheapoffers
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// replaySession answers like Google Flights does for a single route, with the same
+// prices for the same days of the search window on every run, after a fixed latency
+// per request. It makes the benchmarks reproducible offline.
+type replaySession struct {
+	latency time.Duration
+}
+
+// replayPrice is the price of the day offset days into the search window.
+func replayPrice(offset, tripLength int, salt string) float64 {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%d|%d|%s", offset, tripLength, salt)
+	return 300 + float64(h.Sum32()%200)
+}
+
+func (r *replaySession) wait(ctx context.Context) error {
+	select {
+	case <-time.After(r.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *replaySession) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	var offers []flights.Offer
+	for date, offset := args.RangeStartDate, 0; !date.After(args.RangeEndDate); date, offset = date.AddDate(0, 0, 1), offset+1 {
+		offers = append(offers, flights.Offer{
+			StartDate:  date,
+			ReturnDate: date.AddDate(0, 0, args.TripLength),
+			Price:      replayPrice(offset, args.TripLength, "graph"),
+		})
+	}
+	return offers, nil
+}
+
+func (r *replaySession) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, nil, err
+	}
+	offset := int(args.Date.Sub(truncateDay(time.Now())).Hours() / 24)
+	tripLength := int(args.ReturnDate.Sub(args.Date).Hours() / 24)
+	var offers []flights.FullOffer
+	for i, airline := range []string{"UA", "AA", "DL"} {
+		dep := args.Date.Add(time.Duration(7+3*i) * time.Hour)
+		offers = append(offers, flights.FullOffer{
+			Offer: flights.Offer{
+				StartDate:  args.Date,
+				ReturnDate: args.ReturnDate,
+				Price:      replayPrice(offset, tripLength, airline),
+			},
+			Flight: []flights.Flight{
+				{DepAirportCode: "SFO", ArrAirportCode: "ORD", DepTime: dep, ArrTime: dep.Add(4 * time.Hour), FlightNumber: airline + " 100"},
+				{DepAirportCode: "ORD", ArrAirportCode: "JFK", DepTime: dep.Add(6 * time.Hour), ArrTime: dep.Add(8 * time.Hour), FlightNumber: airline + " 200"},
+			},
+			SrcAirportCode: "SFO",
+			DstAirportCode: "JFK",
+			FlightDuration: 8 * time.Hour,
+		})
+	}
+	return offers, &flights.PriceRange{Low: 380, High: 480}, nil
+}
+
+func (r *replaySession) SerializeURL(ctx context.Context, args flights.Args) (string, error) {
+	return "https://www.google.com/travel/flights/search?tfs=replay", r.wait(ctx)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// BenchmarkFind measures complete searches against the replay backend. Besides the
+// time and allocations per search it reports the upstream requests and results of
+// a search; compare runs with benchstat.
+func BenchmarkFind(b *testing.B) {
+	for _, bc := range []struct {
+		name        string
+		days        int
+		tripLengths []int
+		latency     time.Duration
+		tune        func(*Args)
+		cached      bool // the cache already holds the responses of the search
+	}{
+		{name: "30days", days: 30, tripLengths: []int{7}},
+		{name: "60days-3lengths", days: 60, tripLengths: []int{5, 7, 10}},
+		{name: "60days-3lengths-latency", days: 60, tripLengths: []int{5, 7, 10}, latency: 2 * time.Millisecond},
+		{name: "60days-3lengths-latency-serial", days: 60, tripLengths: []int{5, 7, 10}, latency: 2 * time.Millisecond,
+			tune: func(a *Args) { a.MaxConcurrency = 1 }},
+		{name: "60days-3lengths-cached", days: 60, tripLengths: []int{5, 7, 10}, cached: true},
+		{name: "120days-maxrequests", days: 120, tripLengths: []int{7}, latency: time.Millisecond,
+			tune: func(a *Args) { a.MaxRequests = 40 }},
+		{name: "120days-maxresults", days: 120, tripLengths: []int{7}, latency: time.Millisecond,
+			tune: func(a *Args) { a.MaxResults = 5 }},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			start := truncateDay(time.Now()).AddDate(0, 1, 0)
+			args := Args{
+				RangeStartDate: start,
+				RangeEndDate:   start.AddDate(0, 0, bc.days-1),
+				TripLengths:    bc.tripLengths,
+				SrcCities:      []string{"San Francisco"},
+				DstCities:      []string{"New York"},
+				Options:        flights.OptionsDefault(),
+			}
+			if bc.tune != nil {
+				bc.tune(&args)
+			}
+			session := &replaySession{latency: bc.latency}
+			if bc.cached {
+				args.Cache = NewCache(time.Hour)
+				if _, _, err := Find(context.Background(), session, args); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			var upstream, results int
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				res, stats, err := Find(context.Background(), session, args)
+				if err != nil {
+					b.Fatal(err)
+				}
+				upstream += stats.Requests.Upstream()
+				results += len(res)
+			}
+			b.ReportMetric(float64(upstream)/float64(b.N), "upstream/op")
+			b.ReportMetric(float64(results)/float64(b.N), "results/op")
+		})
+	}
+}
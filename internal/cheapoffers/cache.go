@@ -0,0 +1,395 @@
+package cheapoffers
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// maxCacheEntries bounds the number of responses a Cache holds. The oldest responses
+// are evicted first.
+const maxCacheEntries = 10000
+
+const (
+	// maxSnapshotEntries bounds the number of responses Cache.Save writes, the most
+	// used first.
+	maxSnapshotEntries = 1000
+	// maxWarmAge is the age up to which responses loaded by Cache.Load are served
+	// while they're refreshed. Older ones are too stale to be worth answering with.
+	maxWarmAge = 24 * time.Hour
+	// refreshTimeout bounds a background refresh of a response.
+	refreshTimeout = time.Minute
+	// hotCacheUses is the number of requests a response has to answer within its TTL
+	// to be refreshed ahead of its expiry.
+	hotCacheUses = 3
+	// maxRefreshAhead bounds the responses refreshed ahead of their expiry at once.
+	maxRefreshAhead = 10
+)
+
+// Cache remembers upstream responses for a limited time, so that repeated searches
+// over overlapping windows don't search the same dates again. Responses are keyed by
+// dates, route and options. A nil *Cache caches nothing. It is safe for concurrent use
+// by multiple goroutines.
+type Cache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu         sync.Mutex
+	entries    map[string]cacheEntry
+	order      []string        // keys, oldest entry first
+	refreshing map[string]bool // keys of responses being refreshed
+	refreshes  sync.WaitGroup  // background refreshes, waited for by Close
+	closed     bool            // Close was called, no refresh is started
+	closing    context.Context // canceled by Close, cancels the running refreshes
+	close      context.CancelFunc
+	hits       int
+	misses     int
+}
+
+// CacheStats describes the content of a Cache, for operators.
+type CacheStats struct {
+	Entries    int // responses held, including expired ones not evicted yet
+	Warm       int // loaded by Load and not refreshed since
+	Hot        int // answered enough requests to be refreshed ahead of their expiry
+	Refreshing int // being refreshed in the background
+	Hits       int // requests answered
+	Misses     int // requests not answered, including expired responses
+}
+
+type cacheEntry struct {
+	priceGraph []flights.Offer
+	offers     []flights.FullOffer
+	priceRange *flights.PriceRange
+	fetchedAt  time.Time
+	uses       int  // times the response was fetched or answered a request
+	recent     int  // requests answered since the response was fetched
+	warm       bool // loaded by Load and not refreshed since
+	// fetch requests the response again, nil if it was loaded and hasn't answered a
+	// request since.
+	fetch func(context.Context) (cacheEntry, error)
+}
+
+// NewCache returns a cache keeping responses for ttl.
+func NewCache(ttl time.Duration) *Cache {
+	c := &Cache{ttl: ttl, now: time.Now, entries: map[string]cacheEntry{}, refreshing: map[string]bool{}}
+	c.closing, c.close = context.WithCancel(context.Background())
+	return c
+}
+
+// Close cancels the background refreshes, waits for them to return and keeps
+// further ones from starting, so that Save afterwards writes a settled cache. The
+// cache keeps answering requests.
+func (c *Cache) Close() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+	c.close()
+	c.refreshes.Wait()
+}
+
+// Stats returns the current statistics of the cache. A nil *Cache has none.
+func (c *Cache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := CacheStats{Entries: len(c.entries), Refreshing: len(c.refreshing), Hits: c.hits, Misses: c.misses}
+	for _, entry := range c.entries {
+		if entry.warm {
+			stats.Warm++
+		}
+		if entry.recent >= hotCacheUses {
+			stats.Hot++
+		}
+	}
+	return stats
+}
+
+// expired reports whether entry is too old to be served. Loaded responses are
+// served beyond the TTL until they're refreshed.
+func (c *Cache) expired(entry cacheEntry, now time.Time) bool {
+	maxAge := c.ttl
+	if entry.warm {
+		maxAge = max(maxAge, maxWarmAge)
+	}
+	return now.Sub(entry.fetchedAt) >= maxAge
+}
+
+func (c *Cache) load(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || c.expired(entry, c.now()) {
+		c.misses++
+		return cacheEntry{}, false
+	}
+	c.hits++
+	entry.uses++
+	entry.recent++
+	c.entries[key] = entry
+	return entry, true
+}
+
+func (c *Cache) store(key string, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if previous, ok := c.entries[key]; ok {
+		entry.uses += previous.uses
+		c.order = slices.DeleteFunc(c.order, func(k string) bool { return k == key })
+	}
+	c.entries[key] = entry
+	c.order = append(c.order, key)
+
+	now := c.now()
+	for len(c.order) > 0 {
+		oldest := c.entries[c.order[0]]
+		if len(c.order) <= maxCacheEntries && !c.expired(oldest, now) {
+			break
+		}
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+// getPriceGraph returns the cached price graph of args or fetches it. It also returns
+// whether the price graph came from the cache.
+func (c *Cache) getPriceGraph(ctx context.Context, session Session, args flights.PriceGraphArgs) ([]flights.Offer, bool, error) {
+	if c == nil {
+		offers, err := session.GetPriceGraph(ctx, args)
+		return offers, false, err
+	}
+	key := fmt.Sprintf("graph|%s|%s|%d|%v|%v|%v|%v|%+v",
+		args.RangeStartDate.Format(time.DateOnly), args.RangeEndDate.Format(time.DateOnly), args.TripLength,
+		args.SrcCities, args.SrcAirports, args.DstCities, args.DstAirports, args.Options)
+	fetch := func(ctx context.Context) (cacheEntry, error) {
+		offers, err := unmetered(session).GetPriceGraph(ctx, args)
+		return cacheEntry{priceGraph: offers}, err
+	}
+	if entry, ok := c.load(key); ok {
+		if entry.warm {
+			c.refresh(ctx, key, fetch)
+		}
+		return entry.priceGraph, true, nil
+	}
+	offers, err := session.GetPriceGraph(ctx, args)
+	if err != nil {
+		return nil, false, err
+	}
+	c.store(key, cacheEntry{priceGraph: offers, fetchedAt: c.now(), uses: 1, fetch: fetch})
+	return offers, false, nil
+}
+
+// getOffers returns the cached offers of args or fetches them. It also returns when
+// the offers were fetched and whether they came from the cache.
+func (c *Cache) getOffers(ctx context.Context, session Session, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, time.Time, bool, error) {
+	if c == nil {
+		offers, priceRange, err := session.GetOffers(ctx, args)
+		return offers, priceRange, time.Now(), false, err
+	}
+	key := fmt.Sprintf("offers|%s|%s|%v|%v|%v|%v|%+v",
+		args.Date.Format(time.DateOnly), args.ReturnDate.Format(time.DateOnly),
+		args.SrcCities, args.SrcAirports, args.DstCities, args.DstAirports, args.Options)
+	fetch := func(ctx context.Context) (cacheEntry, error) {
+		offers, priceRange, err := unmetered(session).GetOffers(ctx, args)
+		return cacheEntry{offers: offers, priceRange: priceRange}, err
+	}
+	if entry, ok := c.load(key); ok {
+		if entry.warm {
+			c.refresh(ctx, key, fetch)
+		}
+		return entry.offers, entry.priceRange, entry.fetchedAt, true, nil
+	}
+	offers, priceRange, err := session.GetOffers(ctx, args)
+	if err != nil {
+		return nil, nil, time.Time{}, false, err
+	}
+	fetchedAt := c.now()
+	c.store(key, cacheEntry{offers: offers, priceRange: priceRange, fetchedAt: fetchedAt, uses: 1, fetch: fetch})
+	return offers, priceRange, fetchedAt, false, nil
+}
+
+// refresh fetches a response again in the background, unless it's already being
+// refreshed. The old response keeps being served if the refresh fails.
+func (c *Cache) refresh(ctx context.Context, key string, fetch func(context.Context) (cacheEntry, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.refreshing[key] || c.closed {
+		return
+	}
+	c.refreshing[key] = true
+
+	// The refresh outlives the search that triggered it, but not the cache.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+	stop := context.AfterFunc(c.closing, cancel)
+	c.refreshes.Add(1)
+	go func() {
+		defer c.refreshes.Done()
+		defer stop()
+		defer cancel()
+		entry, err := fetch(ctx)
+		if err == nil {
+			entry.fetchedAt = c.now()
+			entry.fetch = fetch
+			c.store(key, entry)
+		}
+		c.mu.Lock()
+		delete(c.refreshing, key)
+		c.mu.Unlock()
+	}()
+}
+
+// RefreshAhead refreshes the responses that answered at least three requests
+// within their TTL in the background shortly before they expire, so that the
+// searches asking for them again don't wait for upstream. allow, if not nil, is
+// asked before every refresh whether the request budget has room for it. It runs
+// until ctx is done.
+func (c *Cache) RefreshAhead(ctx context.Context, allow func() bool) {
+	ticker := time.NewTicker(max(c.ttl/10, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.refreshAhead(ctx, allow)
+		}
+	}
+}
+
+// refreshAhead refreshes the hot responses expiring within the last fifth of the
+// TTL, the most requested first.
+func (c *Cache) refreshAhead(ctx context.Context, allow func() bool) {
+	type hot struct {
+		key    string
+		recent int
+		fetch  func(context.Context) (cacheEntry, error)
+	}
+	c.mu.Lock()
+	now := c.now()
+	var due []hot
+	for key, entry := range c.entries {
+		age := now.Sub(entry.fetchedAt)
+		if entry.warm || entry.fetch == nil || entry.recent < hotCacheUses || c.refreshing[key] ||
+			age < c.ttl-c.ttl/5 || c.expired(entry, now) {
+			continue
+		}
+		due = append(due, hot{key, entry.recent, entry.fetch})
+	}
+	c.mu.Unlock()
+
+	slices.SortFunc(due, func(a, b hot) int { return cmp.Compare(b.recent, a.recent) })
+	for _, h := range due[:min(len(due), maxRefreshAhead)] {
+		if allow != nil && !allow() {
+			return
+		}
+		c.refresh(ctx, h.key, h.fetch)
+	}
+}
+
+// unmetered returns the session a search wraps, so that background requests don't
+// count towards the search.
+func unmetered(session Session) Session {
+	if m, ok := session.(*meteredSession); ok {
+		return m.Session
+	}
+	return session
+}
+
+// snapshotEntry is a response in the file written by Cache.Save.
+type snapshotEntry struct {
+	Key        string              `json:"key"`
+	PriceGraph []flights.Offer     `json:"priceGraph,omitempty"`
+	Offers     []flights.FullOffer `json:"offers,omitempty"`
+	PriceRange *flights.PriceRange `json:"priceRange,omitempty"`
+	FetchedAt  time.Time           `json:"fetchedAt"`
+	Uses       int                 `json:"uses"`
+}
+
+// Save writes the most used responses younger than a day to path, so that Load can
+// warm up the cache of the next process. The file is replaced atomically.
+func (c *Cache) Save(path string) error {
+	c.mu.Lock()
+	now := c.now()
+	var entries []snapshotEntry
+	for i := len(c.order) - 1; i >= 0; i-- { // newest first among equally used ones
+		key := c.order[i]
+		entry := c.entries[key]
+		if now.Sub(entry.fetchedAt) >= maxWarmAge {
+			continue
+		}
+		entries = append(entries, snapshotEntry{
+			Key:        key,
+			PriceGraph: entry.priceGraph,
+			Offers:     entry.offers,
+			PriceRange: entry.priceRange,
+			FetchedAt:  entry.fetchedAt,
+			Uses:       entry.uses,
+		})
+	}
+	c.mu.Unlock()
+
+	slices.SortStableFunc(entries, func(a, b snapshotEntry) int { return cmp.Compare(b.Uses, a.Uses) })
+	entries = entries[:min(len(entries), maxSnapshotEntries)]
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Load adds the responses saved by Save to path and returns their number. They're
+// answered from right away, even beyond the TTL, and the first request one answers
+// refreshes it in the background. A missing file loads nothing.
+func (c *Cache) Load(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var entries []snapshotEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return 0, fmt.Errorf("parse %s: %v", path, err)
+	}
+
+	// Oldest first, as the order of the cache.
+	slices.SortFunc(entries, func(a, b snapshotEntry) int { return a.FetchedAt.Compare(b.FetchedAt) })
+	now := c.now()
+	loaded := 0
+	for _, e := range entries {
+		if now.Sub(e.FetchedAt) >= maxWarmAge {
+			continue
+		}
+		c.store(e.Key, cacheEntry{
+			priceGraph: e.PriceGraph,
+			offers:     e.Offers,
+			priceRange: e.PriceRange,
+			fetchedAt:  e.FetchedAt,
+			uses:       e.Uses,
+			warm:       true,
+		})
+		loaded++
+	}
+	return loaded, nil
+}
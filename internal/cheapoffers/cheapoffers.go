@@ -2,22 +2,199 @@ package cheapoffers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/krisukox/google-flights-api/flights"
 )
 
+// Session is the part of [flights.Session] used to search for offers. It is satisfied
+// by *flights.Session.
+type Session interface {
+	GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error)
+	GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error)
+	SerializeURL(ctx context.Context, args flights.Args) (string, error)
+}
+
 // Args describes the search window and constraints for finding cheap offers.
 type Args struct {
 	RangeStartDate time.Time
 	RangeEndDate   time.Time
 	TripLengths    []int
 	SrcCities      []string
+	SrcAirports    []string
 	DstCities      []string
+	DstAirports    []string
 	Options        flights.Options
+
+	// Filters restrict the offers of the verified dates that are reported.
+	Filters Filters
+
+	// OriginPenalties weight the departure airports by IATA code: the penalty of
+	// an airport is added to the price of its offers when choosing the best offer
+	// of a date and when ranking results, e.g. 80 on the alternatives to a home
+	// airport prefers the home airport unless an alternative saves more than 80.
+	// Prices are reported and compared with Google's low price unchanged.
+	OriginPenalties map[string]float64
+
+	// Preferences add penalties for stops, travel time and departure time the same
+	// way, see Preferences.
+	Preferences Preferences
+
+	// DerankSelfTransfers prefers offers without SelfTransferRisk to riskier ones
+	// regardless of price, when choosing the best offer of a date and when ranking
+	// results.
+	DerankSelfTransfers bool
+
+	// StableOrder breaks all remaining ties by offer ID, so that repeated identical
+	// searches return the same results in the same order.
+	StableOrder bool
+
+	// MaxRequests caps the number of upstream requests of the search, 0 means no cap.
+	// Within the cap, the cheapest price graph dates are verified first.
+	MaxRequests int
+
+	// MaxResults caps the number of results, 0 means no cap. Once this many results
+	// are found, no further dates are verified; the dates already being verified
+	// finish and the cheapest MaxResults results are returned.
+	MaxResults int
+
+	// Deadline, if not zero, is when the search should return. Dates are only
+	// started while a date verification, as long as the ones so far took, still
+	// finishes before it, and the verifications still running at Deadline are
+	// canceled; the dates verified by then are returned. If the price graph
+	// requests suggest that verifying all dates won't fit, the search verifies
+	// them shallowly, see ShallowVerification, and it verifies no more dates at a
+	// time than it needs to finish. Near misses aren't given links once it has
+	// passed.
+	Deadline time.Time
+
+	// MaxConcurrency caps the number of dates verified at the same time, 0 means
+	// DefaultMaxConcurrency.
+	MaxConcurrency int
+
+	// Retries is the number of times a failed price graph request or offer search,
+	// e.g. a throttled one, is sent again before the search fails or the date is
+	// skipped. The wait before a retry doubles with every retry, starting at
+	// RetryBackoff, 0 means DefaultRetryBackoff. Retries only use the requests of
+	// MaxRequests the verified dates leave, and aren't started if they'd end after
+	// Deadline.
+	Retries      int
+	RetryBackoff time.Duration
+
+	// ShallowVerification judges the best offer of a date by Google's price range
+	// of the search that found it, rather than by the range of its airport pair.
+	// Dates then take one request instead of up to two, or three over several
+	// airport pairs, at the cost of comparing the offer with a range over all the
+	// searched cities and airports.
+	ShallowVerification bool
+
+	// Cache, if not nil, answers repeated upstream requests of recent searches.
+	Cache *Cache
+
+	// Guardrails, if not nil, bound the goroutines and result memory of all
+	// searches sharing them.
+	Guardrails *Guardrails
+
+	// Progress, if not nil, is updated while the dates are verified.
+	Progress *Progress
+}
+
+// DefaultMaxConcurrency is the number of dates verified at the same time unless
+// Args.MaxConcurrency says otherwise. Google throttles clients sending many more.
+const DefaultMaxConcurrency = 8
+
+// DefaultRetryBackoff is the wait before the first retry of a failed request unless
+// Args.RetryBackoff says otherwise.
+const DefaultRetryBackoff = 500 * time.Millisecond
+
+// requestsPerDate is the maximum number of upstream requests verifying a price graph
+// date: the search of the date and the search of its best airport pair for the
+// price range. pinnedRequestsPerDate is the one of searches over several pairs,
+// which first search the pinned pair, see pairRanges.searchPinned, and fall back
+// to both searches if it has no offer on the date.
+const (
+	requestsPerDate       = 2
+	pinnedRequestsPerDate = 3
+)
+
+// dateRequests returns the maximum number of upstream requests verifying a
+// price graph date of the search.
+func (args Args) dateRequests() int {
+	switch {
+	case args.ShallowVerification:
+		return 1
+	case severalPairs(args):
+		return pinnedRequestsPerDate
+	}
+	return requestsPerDate
+}
+
+// Stats describes how much of the search window a search covered.
+type Stats struct {
+	PriceGraphDates int  // dates reported by the price graphs
+	VerifiedDates   int  // dates whose offers were searched
+	Truncated       bool // some dates weren't verified because of MaxRequests
+	StoppedEarly    bool // some dates weren't verified because MaxResults were found
+	DeadlineReached bool // some dates weren't verified because they wouldn't have finished before Args.Deadline
+
+	// NearMisses are the cheapest verified offers that weren't below Google's low
+	// price, cheapest first, at most maxNearMisses.
+	NearMisses []Result
+
+	// Skipped are the dates whose verification failed. They count as verified dates.
+	Skipped []SkippedDate
+
+	// Filtered is the number of offers of the verified dates that Args.Filters
+	// rejected.
+	Filtered int
+
+	// Requests counts the upstream requests of the search.
+	Requests RequestStats
+
+	// PriceContext holds the price range Google reported for every verified date
+	// with a priced offer, by departure date.
+	PriceContext []DatePrice
+
+	// Degraded says how the search degraded to stay within Args.Guardrails or
+	// Args.Deadline.
+	Degraded []string
+}
+
+// DatePrice is the cheapest offer of a verified date and Google's price range of it.
+type DatePrice struct {
+	StartDate  time.Time
+	ReturnDate time.Time
+	TripLength int
+	SrcAirport string
+	DstAirport string
+	Price      float64
+	LowPrice   float64
+	HighPrice  float64
+	Qualified  bool // Price is below LowPrice, the date has a Result
+}
+
+// SkippedDate is a price graph date that couldn't be verified.
+type SkippedDate struct {
+	StartDate  time.Time
+	ReturnDate time.Time
+	TripLength int
+	Reason     string
+}
+
+// maxNearMisses is the number of non-qualifying offers reported in Stats.NearMisses.
+const maxNearMisses = 3
+
+// candidate is a price graph date to verify.
+type candidate struct {
+	offer      flights.Offer
+	tripLength int
 }
 
 // Result captures the cheapest qualifying offer for a specific start date.
@@ -27,156 +204,522 @@ type Result struct {
 	SrcAirport    string
 	DstAirport    string
 	Price         float64
+	LowPrice      float64              // Google's low price of the date, the offer is cheaper unless it's a miss
+	HighPrice     float64              // Google's high price of the date
+	Insight       flights.PriceInsight // Google's insight into the prices of the date, zero if it had none
 	TripLength    int
-	ShareableLink string
+	ShareableLink string    // empty if LinkError is set
+	LinkError     string    // why SerializeURL failed; the offer is returned without a link
+	Penalty       float64   // Args.OriginPenalties of SrcAirport plus Args.Preferences, added to Price when ranking
+	FlightNumbers []string  // flight numbers of the outbound flights, e.g. ["LH 1234", "LH 400"]
+	Overnight     bool      // an outbound layover covers the local night, see OvernightLayover
+	SelfTransfer  bool      // an outbound connection changes airports, see SelfTransferRisk
+	FetchedAt     time.Time // when Google Flights returned the offer
+	Cached        bool      // the offer came from Args.Cache
+}
+
+// ID returns a deterministic identifier of the itinerary. The same dates, airports and
+// flights always produce the same ID, independently of the price.
+func (r Result) ID() string {
+	return OfferID(r.StartDate, r.ReturnDate, r.SrcAirport, r.DstAirport, r.FlightNumbers)
+}
+
+// OfferID hashes the identifying fields of an itinerary into a short stable identifier.
+// Both dates are identified by their day, the flight numbers tell the flights of a day
+// apart.
+func OfferID(startDate, returnDate time.Time, srcAirport, dstAirport string, flightNumbers []string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%s|%s|%s|%s",
+		startDate.Format(time.DateOnly),
+		returnDate.Format(time.DateOnly),
+		srcAirport,
+		dstAirport,
+		strings.Join(flightNumbers, ","),
+	)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func (r Result) datePrice(qualified bool) DatePrice {
+	return DatePrice{
+		StartDate:  r.StartDate,
+		ReturnDate: r.ReturnDate,
+		TripLength: r.TripLength,
+		SrcAirport: r.SrcAirport,
+		DstAirport: r.DstAirport,
+		Price:      r.Price,
+		LowPrice:   r.LowPrice,
+		HighPrice:  r.HighPrice,
+		Qualified:  qualified,
+	}
+}
+
+// flightsArgs returns the arguments searching the offers of r's dates and airports.
+func (r Result) flightsArgs(options flights.Options) flights.Args {
+	return flights.Args{
+		Date:        r.StartDate,
+		ReturnDate:  r.ReturnDate,
+		SrcAirports: []string{r.SrcAirport},
+		DstAirports: []string{r.DstAirport},
+		Options:     options,
+	}
+}
+
+// rankingPenalty returns the amount added to the price of offer when ranking it.
+func rankingPenalty(args Args, offer flights.FullOffer) float64 {
+	return args.OriginPenalties[offer.SrcAirportCode] + args.Preferences.Penalty(offer)
+}
+
+func flightNumbers(offer flights.FullOffer) []string {
+	numbers := make([]string, 0, len(offer.Flight))
+	for _, f := range offer.Flight {
+		numbers = append(numbers, f.FlightNumber)
+	}
+	return numbers
 }
 
 // Find locates offers cheaper than Google's advertised low price within the given range.
 // It mirrors the behaviour of examples/example3 but returns structured data instead of logging.
-func Find(ctx context.Context, session *flights.Session, args Args) ([]Result, error) {
+func Find(ctx context.Context, upstream Session, args Args) ([]Result, Stats, error) {
 	if err := validateArgs(args); err != nil {
-		return nil, err
+		return nil, Stats{}, err
 	}
+	start := time.Now()
+	session := newMeteredSession(upstream, args)
 
-	var allResults []Result
-
+	var candidates []candidate
 	for _, tripLength := range args.TripLengths {
-		partial, err := findForTripLength(ctx, session, args, tripLength)
+		priceGraphOffers, cached, err := args.Cache.getPriceGraph(
+			ctx,
+			session,
+			flights.PriceGraphArgs{
+				RangeStartDate: args.RangeStartDate,
+				RangeEndDate:   args.RangeEndDate,
+				TripLength:     tripLength,
+				SrcCities:      args.SrcCities,
+				SrcAirports:    args.SrcAirports,
+				DstCities:      args.DstCities,
+				DstAirports:    args.DstAirports,
+				Options:        args.Options,
+			},
+		)
 		if err != nil {
-			return nil, err
+			return nil, Stats{}, err
+		}
+		session.cached(cached)
+		for _, offer := range priceGraphOffers {
+			candidates = append(candidates, candidate{offer: offer, tripLength: tripLength})
 		}
-		allResults = append(allResults, partial...)
 	}
 
-	sort.Slice(allResults, func(i, j int) bool {
-		if allResults[i].Price == allResults[j].Price {
-			if allResults[i].StartDate.Equal(allResults[j].StartDate) {
-				if allResults[i].ReturnDate.Equal(allResults[j].ReturnDate) {
-					return allResults[i].TripLength < allResults[j].TripLength
-				}
-				return allResults[i].ReturnDate.Before(allResults[j].ReturnDate)
+	// The most promising dates are verified first, so that they are the ones covered
+	// when the search is capped, canceled or times out.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].offer.Price < candidates[j].offer.Price
+	})
+
+	stats := Stats{PriceGraphDates: len(candidates)}
+	priceGraphLatency := time.Since(start) / time.Duration(len(args.TripLengths))
+	plan := planDeadline(args, len(candidates), priceGraphLatency, time.Now())
+	if plan.shallow {
+		args.ShallowVerification = true
+		stats.Degraded = append(stats.Degraded, degradedShallow)
+	}
+	state := newSearchState(session, args, priceGraphLatency)
+	if args.MaxRequests > 0 {
+		budget := (args.MaxRequests - len(args.TripLengths) - int(session.retried.Load())) / args.dateRequests()
+		if len(candidates) > budget {
+			candidates = candidates[:budget]
+			stats.Truncated = true
+		}
+	}
+	session.planRetries(args, len(candidates))
+	args.Progress.queued(len(candidates))
+
+	// Dates are verified one trip length at a time, starting with the trip length of
+	// the cheapest date.
+	var tripLengths []int
+	batches := map[int][]flights.Offer{}
+	for _, c := range candidates {
+		if _, ok := batches[c.tripLength]; !ok {
+			tripLengths = append(tripLengths, c.tripLength)
+		}
+		batches[c.tripLength] = append(batches[c.tripLength], c.offer)
+	}
+
+	var (
+		allResults, misses []Result
+		firstErr           error
+	)
+
+	if len(candidates) > 0 {
+		want := args.MaxConcurrency
+		if want == 0 {
+			want = DefaultMaxConcurrency
+		}
+		want = min(want, len(candidates))
+		if plan.concurrency > 0 {
+			want = min(want, plan.concurrency)
+		}
+		got, err := args.Guardrails.reserve(ctx, want)
+		if err != nil {
+			return nil, Stats{}, err
+		}
+		defer args.Guardrails.release(got)
+		if got < want {
+			stats.Degraded = append(stats.Degraded, degradedFanOut(got, want))
+		}
+		args.MaxConcurrency = got
+	}
+	retained := retention{guardrails: args.Guardrails}
+	defer retained.release()
+
+	// The verifications still running at the deadline are canceled, the dates
+	// verified by then are returned.
+	verifyCtx := ctx
+	if !args.Deadline.IsZero() {
+		var cancel context.CancelFunc
+		verifyCtx, cancel = context.WithDeadline(ctx, args.Deadline)
+		defer cancel()
+	}
+
+	for _, tripLength := range tripLengths {
+		batch := verifyDates(verifyCtx, session, args, state, tripLength, batches[tripLength])
+		// Dates that were never started or failed because the search was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, Stats{}, err
+		}
+		stats.VerifiedDates += batch.started - batch.canceled
+		if batch.canceled > 0 {
+			stats.DeadlineReached = true
+		} else if batch.started < len(batches[tripLength]) {
+			if state.enough(args) {
+				stats.StoppedEarly = true
+			} else {
+				stats.DeadlineReached = true
+			}
+		}
+		allResults, misses = retained.add(args, allResults, misses, batch.results, batch.misses)
+		stats.Skipped = append(stats.Skipped, batch.skipped...)
+		stats.Filtered += batch.filtered
+		if firstErr == nil {
+			firstErr = batch.err
+		}
+	}
+	if retained.trimmed {
+		stats.Degraded = append(stats.Degraded, degradedRetention)
+	}
+	// Failures of single dates are reported in the stats, unless nothing but
+	// failures is left to report.
+	if len(stats.Skipped) > 0 && len(stats.Skipped) == stats.VerifiedDates {
+		return nil, Stats{}, firstErr
+	}
+
+	for _, r := range allResults {
+		stats.PriceContext = append(stats.PriceContext, r.datePrice(true))
+	}
+	for _, r := range misses {
+		stats.PriceContext = append(stats.PriceContext, r.datePrice(false))
+	}
+	sort.Slice(stats.PriceContext, func(i, j int) bool {
+		a, b := stats.PriceContext[i], stats.PriceContext[j]
+		if !a.StartDate.Equal(b.StartDate) {
+			return a.StartDate.Before(b.StartDate)
+		}
+		return a.TripLength < b.TripLength
+	})
+
+	sort.Slice(misses, func(i, j int) bool {
+		return lessResult(misses[i], misses[j], args)
+	})
+	for _, miss := range misses[:min(len(misses), maxNearMisses)] {
+		// A near miss is reported without a link rather than not at all.
+		if !state.pastDeadline(time.Now()) {
+			if link, err := session.SerializeURL(ctx, miss.flightsArgs(args.Options)); err == nil {
+				miss.ShareableLink = link
 			}
-			return allResults[i].StartDate.Before(allResults[j].StartDate)
 		}
-		return allResults[i].Price < allResults[j].Price
+		stats.NearMisses = append(stats.NearMisses, miss)
+	}
+
+	sort.Slice(allResults, func(i, j int) bool {
+		return lessResult(allResults[i], allResults[j], args)
 	})
+	if args.MaxResults > 0 && len(allResults) > args.MaxResults {
+		allResults = allResults[:args.MaxResults]
+	}
 
-	return allResults, nil
+	stats.Requests = session.stats(start)
+	return allResults, stats, nil
 }
 
-func findForTripLength(ctx context.Context, session *flights.Session, args Args, tripLength int) ([]Result, error) {
-	priceGraphOffers, err := session.GetPriceGraph(
-		ctx,
-		flights.PriceGraphArgs{
-			RangeStartDate: args.RangeStartDate,
-			RangeEndDate:   args.RangeEndDate,
-			TripLength:     tripLength,
-			SrcCities:      args.SrcCities,
-			DstCities:      args.DstCities,
-			Options:        args.Options,
-		},
-	)
-	if err != nil {
-		return nil, err
+// lessResult orders results by price including their penalty, then by start date,
+// return date and trip length. With Args.DerankSelfTransfers, results with
+// self-transfer risk come last. With Args.StableOrder, results equal in all of
+// these are ordered by their ID.
+func lessResult(a, b Result, args Args) bool {
+	if args.DerankSelfTransfers && a.SelfTransfer != b.SelfTransfer {
+		return b.SelfTransfer
+	}
+	if a.Price+a.Penalty != b.Price+b.Penalty {
+		return a.Price+a.Penalty < b.Price+b.Penalty
+	}
+	if !a.StartDate.Equal(b.StartDate) {
+		return a.StartDate.Before(b.StartDate)
+	}
+	if !a.ReturnDate.Equal(b.ReturnDate) {
+		return a.ReturnDate.Before(b.ReturnDate)
+	}
+	if a.TripLength != b.TripLength || !args.StableOrder {
+		return a.TripLength < b.TripLength
+	}
+	return a.ID() < b.ID()
+}
+
+// FullOfferID returns the ID of the Result built from offer.
+func FullOfferID(offer flights.FullOffer) string {
+	return OfferID(offer.StartDate, offer.ReturnDate, offer.SrcAirportCode, offer.DstAirportCode, flightNumbers(offer))
+}
+
+// searchState is shared by the verifications of all trip lengths of a search.
+type searchState struct {
+	ranges *priceRanges
+	found  atomic.Int64 // results found so far
+
+	deadline   time.Time     // see Args.Deadline
+	firstGuess time.Duration // duration of a date verification until one finished
+	dates      atomic.Int64  // date verifications finished
+	datesNanos atomic.Int64  // their summed duration
+}
+
+// newSearchState returns the state of a search whose price graph requests took
+// priceGraphLatency each, which is the first guess of a request's latency.
+func newSearchState(session *meteredSession, args Args, priceGraphLatency time.Duration) *searchState {
+	return &searchState{
+		ranges:     newPriceRanges(session, args),
+		deadline:   args.Deadline,
+		firstGuess: time.Duration(args.dateRequests()) * priceGraphLatency,
+	}
+}
+
+// dateDone records a date verification that started at start.
+func (s *searchState) dateDone(start time.Time) {
+	s.datesNanos.Add(int64(time.Since(start)))
+	s.dates.Add(1)
+}
+
+// fits reports whether a date verification started at now finishes before the
+// deadline, judging by the ones finished so far.
+func (s *searchState) fits(now time.Time) bool {
+	if s.deadline.IsZero() {
+		return true
+	}
+	estimate := s.firstGuess
+	if dates := s.dates.Load(); dates > 0 {
+		estimate = time.Duration(s.datesNanos.Load() / dates)
+	}
+	return !now.Add(estimate).After(s.deadline)
+}
+
+// pastDeadline reports whether the deadline passed by now.
+func (s *searchState) pastDeadline(now time.Time) bool {
+	return !s.deadline.IsZero() && now.After(s.deadline)
+}
+
+// enough reports whether args.MaxResults results were found.
+func (s *searchState) enough(args Args) bool {
+	return args.MaxResults > 0 && s.found.Load() >= int64(args.MaxResults)
+}
+
+// deadlinePlan is how a search with Args.Deadline verifies its dates.
+type deadlinePlan struct {
+	shallow     bool // verify the dates shallowly, see Args.ShallowVerification
+	concurrency int  // dates verified at a time, 0 leaves Args.MaxConcurrency
+}
+
+// planDeadline plans the verification of dates price graph dates at now, guessing
+// that an upstream request takes latency. Verifying all dates before args.Deadline
+// at args.MaxConcurrency dates at a time takes precedence over verifying them
+// fully; within that, the search verifies as few dates at a time as it can,
+// planning on half the time left so that slower requests still fit.
+func planDeadline(args Args, dates int, latency time.Duration, now time.Time) deadlinePlan {
+	var plan deadlinePlan
+	if args.Deadline.IsZero() || dates == 0 || latency <= 0 {
+		return plan
+	}
+	maxConcurrency := args.MaxConcurrency
+	if maxConcurrency == 0 {
+		maxConcurrency = DefaultMaxConcurrency
 	}
+	left := args.Deadline.Sub(now) / 2
+	// rounds is the number of dates a verification slot gets through in time.
+	rounds := int(left / (time.Duration(args.dateRequests()) * latency))
+	if !args.ShallowVerification && rounds*maxConcurrency < dates {
+		plan.shallow = true
+		rounds = int(left / latency)
+	}
+	if rounds > 0 {
+		plan.concurrency = min(maxConcurrency, (dates+rounds-1)/rounds)
+	}
+	return plan
+}
 
-	ctxWithCancel, cancel := context.WithCancel(ctx)
-	defer cancel()
+// degradedShallow describes a search that verified its dates shallowly to verify
+// them all before its deadline.
+const degradedShallow = "judged each date by the price range of its search rather than of its airport pair, to verify all dates before the deadline"
+
+// verifiedBatch is the outcome of verifying the dates of one trip length.
+type verifiedBatch struct {
+	started  int           // dates whose verification was started
+	canceled int           // started dates canceled at args.Deadline
+	results  []Result      // the cheapest offers below Google's low price
+	misses   []Result      // the cheapest offers of the other dates
+	skipped  []SkippedDate // dates whose verification failed
+	filtered int           // offers rejected by args.Filters
+	err      error         // error of the first skipped date
+}
 
+// verifyDates searches the offers of the price graph dates of one trip length, up to
+// args.MaxConcurrency dates at a time, starting them in the given order. A date that
+// fails is skipped, the others are still verified. Once args.MaxResults results are
+// found, or a date wouldn't finish before args.Deadline, no further dates are
+// started. Once ctx is done, dates are no longer started and the failures of the
+// running ones are counted as canceled rather than skipped.
+func verifyDates(ctx context.Context, session *meteredSession, args Args, state *searchState, tripLength int, priceGraphOffers []flights.Offer) verifiedBatch {
 	type resultOrError struct {
 		result Result
+		miss   bool
 		err    error
+		offer  flights.Offer // the price graph date, set with err
 	}
 
 	resultsCh := make(chan resultOrError, len(priceGraphOffers))
 
-	var wg sync.WaitGroup
-	wg.Add(len(priceGraphOffers))
+	maxConcurrency := args.MaxConcurrency
+	if maxConcurrency == 0 {
+		maxConcurrency = DefaultMaxConcurrency
+	}
+	sem := make(chan struct{}, maxConcurrency)
+
+	var (
+		wg       sync.WaitGroup
+		filtered atomic.Int64
+		started  int
+	)
 
 	for _, priceGraphOffer := range priceGraphOffers {
 		offer := priceGraphOffer
+
+		// Taking the slot before starting the goroutine keeps the order of the dates.
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		// Results may have been found, or the deadline come closer, while waiting
+		// for the slot.
+		if state.enough(args) || !state.fits(time.Now()) {
+			<-sem
+			break
+		}
+		started++
+
+		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			defer func() { <-sem }()
+			defer args.Progress.started()()
+			defer state.dateDone(time.Now())
 
-			fullOffers, _, err := session.GetOffers(
-				ctxWithCancel,
-				flights.Args{
-					Date:       offer.StartDate,
-					ReturnDate: offer.ReturnDate,
-					SrcCities:  args.SrcCities,
-					DstCities:  args.DstCities,
-					Options:    args.Options,
-				},
+			searched := flights.Args{
+				Date:        offer.StartDate,
+				ReturnDate:  offer.ReturnDate,
+				SrcCities:   args.SrcCities,
+				SrcAirports: args.SrcAirports,
+				DstCities:   args.DstCities,
+				DstAirports: args.DstAirports,
+				Options:     args.Options,
+			}
+			// Once the best airport pair of a date is known, the later dates of a
+			// search over several pairs search that pair only, which also answers
+			// its price range. A date the pair has no offer on searches all pairs.
+			var (
+				search    pairSearch
+				pinned    bool
+				bestOffer flights.FullOffer
+				bestPrice float64 // price of bestOffer including its penalty
+				rejected  int64
+				err       error
 			)
-			if err != nil {
-				cancel()
-				resultsCh <- resultOrError{err: err}
-				return
+			if !args.ShallowVerification && severalPairs(args) {
+				if search, pinned, err = state.ranges.searchPinned(ctx, offer); err != nil {
+					resultsCh <- resultOrError{err: err, offer: offer}
+					return
+				}
+				bestOffer, bestPrice, rejected = pickBestOffer(args, search.offers)
 			}
-
-			var bestOffer flights.FullOffer
-			for _, fullOffer := range fullOffers {
-				if fullOffer.Price == 0 {
-					continue
+			if bestOffer.Price == 0 {
+				pinned = false
+				search.offers, search.priceRange, search.fetchedAt, search.cached, err = args.Cache.getOffers(ctx, session, searched)
+				if err != nil {
+					resultsCh <- resultOrError{err: err, offer: offer}
+					return
 				}
-				if bestOffer.Price == 0 || fullOffer.Price < bestOffer.Price {
-					bestOffer = fullOffer
+				session.cached(search.cached)
+				bestOffer, bestPrice, rejected = pickBestOffer(args, search.offers)
+				if bestOffer.Price > 0 && severalPairs(args) {
+					state.ranges.pin(bestOffer, bestPrice)
 				}
 			}
+			filtered.Add(rejected)
 			if bestOffer.Price == 0 {
 				return
 			}
 
-			_, priceRange, err := session.GetOffers(
-				ctxWithCancel,
-				flights.Args{
-					Date:        bestOffer.StartDate,
-					ReturnDate:  bestOffer.ReturnDate,
-					SrcAirports: []string{bestOffer.SrcAirportCode},
-					DstAirports: []string{bestOffer.DstAirportCode},
-					Options:     args.Options,
-				},
-			)
-			if err != nil {
-				cancel()
-				resultsCh <- resultOrError{err: err}
-				return
+			priceRange := search.priceRange
+			if !args.ShallowVerification && !pinned {
+				state.ranges.seed(searched, bestOffer, search)
+				if priceRange, err = state.ranges.get(ctx, bestOffer); err != nil {
+					resultsCh <- resultOrError{err: err, offer: offer}
+					return
+				}
 			}
 			if priceRange == nil {
 				return
 			}
 
+			result := Result{
+				StartDate:     bestOffer.StartDate,
+				ReturnDate:    bestOffer.ReturnDate,
+				SrcAirport:    bestOffer.SrcAirportCode,
+				DstAirport:    bestOffer.DstAirportCode,
+				Price:         bestOffer.Price,
+				LowPrice:      priceRange.Low,
+				HighPrice:     priceRange.High,
+				Insight:       priceRange.Insight,
+				Penalty:       rankingPenalty(args, bestOffer),
+				TripLength:    tripLength,
+				FlightNumbers: flightNumbers(bestOffer),
+				Overnight:     OvernightLayover(bestOffer),
+				SelfTransfer:  SelfTransferRisk(bestOffer),
+				FetchedAt:     search.fetchedAt,
+				Cached:        search.cached,
+			}
 			if bestOffer.Price >= priceRange.Low {
+				resultsCh <- resultOrError{result: result, miss: true}
 				return
 			}
 
-			url, err := session.SerializeURL(
-				ctxWithCancel,
-				flights.Args{
-					Date:        bestOffer.StartDate,
-					ReturnDate:  bestOffer.ReturnDate,
-					SrcAirports: []string{bestOffer.SrcAirportCode},
-					DstAirports: []string{bestOffer.DstAirportCode},
-					Options:     args.Options,
-				},
-			)
-			if err != nil {
-				cancel()
-				resultsCh <- resultOrError{err: err}
-				return
+			// A link that can't be generated doesn't make the offer less bookable, the
+			// client can ask for it again later.
+			if result.ShareableLink, err = session.SerializeURL(ctx, result.flightsArgs(args.Options)); err != nil {
+				result.LinkError = err.Error()
 			}
 
-			resultsCh <- resultOrError{
-				result: Result{
-					StartDate:     bestOffer.StartDate,
-					ReturnDate:    bestOffer.ReturnDate,
-					SrcAirport:    bestOffer.SrcAirportCode,
-					DstAirport:    bestOffer.DstAirportCode,
-					Price:         bestOffer.Price,
-					TripLength:    tripLength,
-					ShareableLink: url,
-				},
-			}
+			args.Progress.found()
+			state.found.Add(1)
+			resultsCh <- resultOrError{result: result}
 		}()
 	}
 
@@ -185,29 +728,67 @@ func findForTripLength(ctx context.Context, session *flights.Session, args Args,
 		close(resultsCh)
 	}()
 
-	var (
-		results  []Result
-		firstErr error
-	)
-
+	batch := verifiedBatch{started: started}
+	args.Progress.queued(started - len(priceGraphOffers))
 	for item := range resultsCh {
-		if item.err != nil {
-			if firstErr == nil {
-				firstErr = item.err
+		switch {
+		case item.err != nil && ctx.Err() != nil:
+			batch.canceled++
+		case item.err != nil:
+			batch.skipped = append(batch.skipped, SkippedDate{
+				StartDate:  item.offer.StartDate,
+				ReturnDate: item.offer.ReturnDate,
+				TripLength: tripLength,
+				Reason:     item.err.Error(),
+			})
+			if batch.err == nil {
+				batch.err = item.err
 			}
-			continue
+		case item.miss:
+			batch.misses = append(batch.misses, item.result)
+		default:
+			batch.results = append(batch.results, item.result)
 		}
-		results = append(results, item.result)
 	}
+	batch.filtered = int(filtered.Load())
+	return batch
+}
 
-	if firstErr != nil {
-		return nil, firstErr
+// pickBestOffer returns the offer of a search that ranks first, its price including
+// its penalty and the number of offers Args.Filters rejected. The offer is zero if
+// the search has no priced offer passing the filters.
+func pickBestOffer(args Args, fullOffers []flights.FullOffer) (bestOffer flights.FullOffer, bestPrice float64, rejected int64) {
+	var bestRisk bool // bestOffer is deranked for self-transfer risk
+	for _, fullOffer := range fullOffers {
+		if fullOffer.Price == 0 {
+			continue
+		}
+		if !args.Filters.Match(fullOffer) {
+			rejected++
+			continue
+		}
+		price := fullOffer.Price + rankingPenalty(args, fullOffer)
+		risk := args.DerankSelfTransfers && SelfTransferRisk(fullOffer)
+		switch {
+		case bestOffer.Price == 0:
+			bestOffer, bestPrice, bestRisk = fullOffer, price, risk
+		case risk != bestRisk:
+			if bestRisk {
+				bestOffer, bestPrice, bestRisk = fullOffer, price, risk
+			}
+		case price < bestPrice:
+			bestOffer, bestPrice, bestRisk = fullOffer, price, risk
+		case args.StableOrder && price == bestPrice && FullOfferID(fullOffer) < FullOfferID(bestOffer):
+			bestOffer, bestPrice, bestRisk = fullOffer, price, risk
+		}
 	}
-
-	return results, nil
+	return bestOffer, bestPrice, rejected
 }
 
 func validateArgs(args Args) error {
+	if args.Options.TripType == flights.OneWay {
+		return fmt.Errorf("one-way trips aren't supported, the price graph only covers round trips; search exact dates instead")
+	}
 	if len(args.TripLengths) == 0 {
 		return fmt.Errorf("at least one trip length is required")
 	}
@@ -216,14 +797,40 @@ func validateArgs(args Args) error {
 			return fmt.Errorf("trip lengths must be positive")
 		}
 	}
+	if args.MaxConcurrency < 0 {
+		return fmt.Errorf("maxConcurrency must not be negative")
+	}
+	if args.MaxRequests < 0 {
+		return fmt.Errorf("maxRequests must not be negative")
+	}
+	if args.Retries < 0 {
+		return fmt.Errorf("retries must not be negative")
+	}
+	if args.MaxResults < 0 {
+		return fmt.Errorf("maxResults must not be negative")
+	}
+	if minimum := len(args.TripLengths) + args.dateRequests(); args.MaxRequests > 0 && args.MaxRequests < minimum {
+		return fmt.Errorf("maxRequests must be at least %d to search at least one date", minimum)
+	}
 	if args.RangeEndDate.Before(args.RangeStartDate) {
 		return fmt.Errorf("rangeEndDate must be on or after rangeStartDate")
 	}
-	if len(args.SrcCities) == 0 {
-		return fmt.Errorf("at least one source city is required")
+	if err := args.Filters.Validate(); err != nil {
+		return err
+	}
+	if err := args.Preferences.Validate(); err != nil {
+		return err
+	}
+	for airport, penalty := range args.OriginPenalties {
+		if penalty < 0 {
+			return fmt.Errorf("the penalty of origin %s must not be negative", airport)
+		}
+	}
+	if len(args.SrcCities) == 0 && len(args.SrcAirports) == 0 {
+		return fmt.Errorf("at least one source city or airport is required")
 	}
-	if len(args.DstCities) == 0 {
-		return fmt.Errorf("at least one destination city is required")
+	if len(args.DstCities) == 0 && len(args.DstAirports) == 0 {
+		return fmt.Errorf("at least one destination city or airport is required")
 	}
 	return nil
 }
@@ -0,0 +1,869 @@
+package cheapoffers
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// fakeSession serves a fixed price graph and delegates GetOffers to getOffers.
+type fakeSession struct {
+	graph        []flights.Offer
+	getOffers    func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error)
+	serializeErr error // returned by SerializeURL
+}
+
+func (f *fakeSession) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	return f.graph, ctx.Err()
+}
+
+func (f *fakeSession) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	return f.getOffers(ctx, args)
+}
+
+func (f *fakeSession) SerializeURL(ctx context.Context, args flights.Args) (string, error) {
+	if f.serializeErr != nil {
+		return "", f.serializeErr
+	}
+	return "https://www.google.com/travel/flights/search", nil
+}
+
+func priceGraph(days int) []flights.Offer {
+	start := time.Now().AddDate(0, 1, 0).Truncate(24 * time.Hour)
+	offers := make([]flights.Offer, 0, days)
+	for i := 0; i < days; i++ {
+		date := start.AddDate(0, 0, i)
+		offers = append(offers, flights.Offer{StartDate: date, ReturnDate: date.AddDate(0, 0, 7), Price: float64(100 + i)})
+	}
+	return offers
+}
+
+func testArgs() Args {
+	return Args{
+		RangeStartDate: time.Now().AddDate(0, 1, 0),
+		RangeEndDate:   time.Now().AddDate(0, 2, 0),
+		TripLengths:    []int{7},
+		SrcCities:      []string{"San Francisco"},
+		DstCities:      []string{"New York"},
+		Options:        flights.OptionsDefault(),
+	}
+}
+
+func TestFindCanceled(t *testing.T) {
+	var active, started atomic.Int32
+	firstCall := make(chan struct{})
+
+	session := &fakeSession{
+		graph: priceGraph(30),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			active.Add(1)
+			defer active.Add(-1)
+			if started.Add(1) == 1 {
+				close(firstCall)
+			}
+			<-ctx.Done()
+			return nil, nil, ctx.Err()
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-firstCall
+		cancel()
+	}()
+
+	done := make(chan error)
+	go func() {
+		_, _, err := Find(ctx, session, testArgs())
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Find didn't return after the context was canceled")
+	}
+
+	if n := active.Load(); n != 0 {
+		t.Fatalf("%d upstream calls still running after Find returned", n)
+	}
+}
+
+func TestFindSkipsFailedDates(t *testing.T) {
+	upstreamErr := errors.New("upstream failure")
+	graph := priceGraph(5)
+	failing := graph[2].StartDate
+
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			if args.Date.Equal(failing) {
+				return nil, nil, upstreamErr
+			}
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100}}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+
+	results, stats, err := Find(context.Background(), session, testArgs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected the 4 other dates to be found, got %d", len(results))
+	}
+	expected := []SkippedDate{{StartDate: failing, ReturnDate: graph[2].ReturnDate, TripLength: 7, Reason: "upstream failure"}}
+	if !reflect.DeepEqual(stats.Skipped, expected) {
+		t.Fatalf("unexpected skipped dates: %+v", stats.Skipped)
+	}
+
+	// A search whose dates all fail returns the error.
+	session.getOffers = func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+		return nil, nil, upstreamErr
+	}
+	if _, _, err := Find(context.Background(), session, testArgs()); !errors.Is(err, upstreamErr) {
+		t.Fatalf("expected the upstream error, got %v", err)
+	}
+}
+
+func TestFindRetries(t *testing.T) {
+	graph := priceGraph(5)
+
+	var mu sync.Mutex
+	failed := map[time.Time]bool{}
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			// The first search of every date is throttled.
+			if !failed[args.Date] {
+				failed[args.Date] = true
+				return nil, nil, errors.New("throttled")
+			}
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100}}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+
+	args := testArgs()
+	args.Retries = 1
+	args.RetryBackoff = time.Millisecond
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 5 || len(stats.Skipped) != 0 || stats.Requests.Retries != 5 {
+		t.Fatalf("expected every date to be found after a retry, got %d results and %+v", len(results), stats)
+	}
+
+	// Retries only use the requests MaxRequests leaves: one date of the several
+	// airport pairs fits in four requests along with the price graph, which leaves
+	// one retry.
+	clear(failed)
+	args.MaxRequests = 1 + 1 + pinnedRequestsPerDate
+	results, stats, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || stats.Requests.Retries != 1 || stats.Requests.PriceGraph+stats.Requests.Offers > args.MaxRequests {
+		t.Fatalf("expected a single retry within maxRequests, got %d results and %+v", len(results), stats.Requests)
+	}
+
+	// Without retries, every date fails.
+	clear(failed)
+	args.Retries, args.MaxRequests = 0, 0
+	if _, _, err := Find(context.Background(), session, args); err == nil {
+		t.Fatal("expected the throttled searches to fail")
+	}
+}
+
+func TestFindStableOrder(t *testing.T) {
+	var calls atomic.Int32
+
+	session := &fakeSession{
+		graph: priceGraph(5),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			if len(args.SrcAirports) > 0 {
+				return nil, &flights.PriceRange{Low: 1000, High: 2000}, nil
+			}
+			offers := []flights.FullOffer{
+				{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 500}, SrcAirportCode: "SFO", DstAirportCode: "JFK"},
+				{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 500}, SrcAirportCode: "OAK", DstAirportCode: "EWR"},
+			}
+			// Google doesn't guarantee the order of equally priced offers.
+			if calls.Add(1)%2 == 0 {
+				offers[0], offers[1] = offers[1], offers[0]
+			}
+			return offers, nil, nil
+		},
+	}
+
+	args := testArgs()
+	args.StableOrder = true
+
+	first, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(first))
+	}
+	for _, r := range first {
+		if r.FetchedAt.IsZero() {
+			t.Fatalf("result without fetch time: %+v", r)
+		}
+	}
+	first = withoutFetchTimes(first)
+	for i := 0; i < 5; i++ {
+		results, _, err := Find(context.Background(), session, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results = withoutFetchTimes(results); !reflect.DeepEqual(first, results) {
+			t.Fatalf("results differ between identical searches:\n%v\n%v", first, results)
+		}
+	}
+}
+
+// withoutFetchTimes clears the fetch times, which differ between otherwise identical results.
+func withoutFetchTimes(results []Result) []Result {
+	for i := range results {
+		results[i].FetchedAt = time.Time{}
+	}
+	return results
+}
+
+func TestFindMaxRequests(t *testing.T) {
+	graph := priceGraph(30)
+	slices.Reverse(graph) // the cheapest dates come last
+
+	var mu sync.Mutex
+	var searched []float64
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			if len(args.SrcAirports) > 0 {
+				return nil, &flights.PriceRange{Low: 1000, High: 2000}, nil
+			}
+			for _, o := range graph {
+				if o.StartDate.Equal(args.Date) {
+					mu.Lock()
+					searched = append(searched, o.Price)
+					mu.Unlock()
+				}
+			}
+			return []flights.FullOffer{{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 500}}}, nil, nil
+		},
+	}
+
+	args := testArgs()
+	args.MaxRequests = 1 + 5*pinnedRequestsPerDate
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stats.Truncated || stats.PriceGraphDates != 30 || stats.VerifiedDates != 5 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	slices.Sort(searched)
+	if !reflect.DeepEqual(searched, []float64{100, 101, 102, 103, 104}) {
+		t.Fatalf("expected the cheapest dates to be searched, got: %v", searched)
+	}
+
+	args.MaxRequests = 2
+	if _, _, err := Find(context.Background(), session, args); err == nil {
+		t.Fatal("expected an error for a cap too small to verify any date")
+	}
+}
+
+func TestFindOneWay(t *testing.T) {
+	args := testArgs()
+	args.Options.TripType = flights.OneWay
+	if _, _, err := Find(context.Background(), &fakeSession{}, args); err == nil {
+		t.Fatal("expected an error for a one-way search")
+	}
+}
+
+func TestFindLinkError(t *testing.T) {
+	session := &fakeSession{
+		graph: priceGraph(3),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+		serializeErr: errors.New("city lookup failed"),
+	}
+
+	results, stats, err := Find(context.Background(), session, testArgs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || len(stats.Skipped) != 0 {
+		t.Fatalf("expected 3 results without skipped dates, got %+v and %+v", results, stats.Skipped)
+	}
+	for _, r := range results {
+		if r.ShareableLink != "" || r.LinkError != "city lookup failed" {
+			t.Fatalf("expected a result without a link, got %+v", r)
+		}
+	}
+}
+
+func TestFindMaxConcurrency(t *testing.T) {
+	var active, peak atomic.Int32
+	session := &fakeSession{
+		graph: priceGraph(20),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			n := active.Add(1)
+			defer active.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil, nil, nil
+		},
+	}
+
+	args := testArgs()
+	args.MaxConcurrency = 3
+	if _, _, err := Find(context.Background(), session, args); err != nil {
+		t.Fatal(err)
+	}
+	if p := peak.Load(); p != 3 {
+		t.Fatalf("expected 3 concurrent requests, got %d", p)
+	}
+}
+
+func TestFindCache(t *testing.T) {
+	var calls atomic.Int32
+	session := &fakeSession{
+		graph: priceGraph(5),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			calls.Add(1)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	}
+
+	args := testArgs()
+	args.Cache = NewCache(time.Minute)
+	args.MaxConcurrency = 1 // the first date pins the airport pair of the others
+	first, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upstream := calls.Load()
+	if len(first) != 5 || first[0].Cached {
+		t.Fatalf("expected 5 live results, got %+v", first)
+	}
+	// One price graph, a city and an airport pair search of the first date, an airport
+	// pair search of the others and a link per date.
+	if r := stats.Requests; r.PriceGraph != 1 || r.Offers != 6 || r.Links != 5 || r.CacheHits != 0 || r.Upstream() != 12 {
+		t.Fatalf("wrong request stats of the first search: %+v", r)
+	}
+
+	second, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != upstream {
+		t.Fatalf("the repeated search hit upstream %d more times", calls.Load()-upstream)
+	}
+	if r := stats.Requests; r.PriceGraph != 0 || r.Offers != 0 || r.CacheHits != 7 {
+		t.Fatalf("wrong request stats of the cached search: %+v", r)
+	}
+	for i := range second {
+		if !second[i].Cached || !second[i].FetchedAt.Equal(first[i].FetchedAt) {
+			t.Fatalf("expected the cached result with its original fetch time: %+v", second[i])
+		}
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	now := time.Now()
+	c := NewCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.store("a", cacheEntry{fetchedAt: now})
+	if _, ok := c.load("a"); !ok {
+		t.Fatal("expected a fresh entry")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.load("a"); ok {
+		t.Fatal("expected the entry to expire")
+	}
+	c.store("b", cacheEntry{fetchedAt: now})
+	if len(c.entries) != 1 || len(c.order) != 1 {
+		t.Fatalf("expected the expired entry to be evicted, got %d entries", len(c.entries))
+	}
+}
+
+func TestCacheSnapshot(t *testing.T) {
+	var calls atomic.Int32
+	session := &fakeSession{
+		graph: priceGraph(5),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			calls.Add(1)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	}
+	path := filepath.Join(t.TempDir(), "cache.json")
+
+	args := testArgs()
+	args.Cache = NewCache(time.Minute)
+	args.MaxConcurrency = 1
+	if _, _, err := Find(context.Background(), session, args); err != nil {
+		t.Fatal(err)
+	}
+	if err := args.Cache.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// The next process starts after the TTL.
+	now := time.Now().Add(time.Hour)
+	args.Cache = NewCache(time.Minute)
+	args.Cache.now = func() time.Time { return now }
+	if n, err := args.Cache.Load(path); err != nil || n != 7 {
+		t.Fatalf("expected 7 loaded responses, got %d, %v", n, err)
+	}
+	upstream := calls.Load()
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 5 || !results[0].Cached || stats.Requests.Offers != 0 {
+		t.Fatalf("expected the loaded responses to answer the search: %+v %+v", results, stats.Requests)
+	}
+	args.Cache.refreshes.Wait()
+	if refreshed := calls.Load() - upstream; refreshed != 6 {
+		t.Fatalf("expected the 6 offer searches to be refreshed, got %d", refreshed)
+	}
+	for key, entry := range args.Cache.entries {
+		if entry.warm || !entry.fetchedAt.Equal(now) {
+			t.Fatalf("expected %s to be refreshed: %+v", key, entry)
+		}
+	}
+
+	// Too old to be served.
+	now = now.Add(maxWarmAge)
+	stale := NewCache(time.Minute)
+	stale.now = func() time.Time { return now }
+	if n, err := stale.Load(path); err != nil || n != 0 {
+		t.Fatalf("expected no loaded responses, got %d, %v", n, err)
+	}
+	if n, err := stale.Load(filepath.Join(t.TempDir(), "missing.json")); err != nil || n != 0 {
+		t.Fatalf("expected a missing file to load nothing, got %d, %v", n, err)
+	}
+}
+
+func TestCacheRefreshAhead(t *testing.T) {
+	now := time.Now()
+	c := NewCache(10 * time.Minute)
+	c.now = func() time.Time { return now }
+	var fetched []string
+	var mu sync.Mutex
+	fetcher := func(key string) func(context.Context) (cacheEntry, error) {
+		return func(context.Context) (cacheEntry, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fetched = append(fetched, key)
+			return cacheEntry{priceGraph: priceGraph(1)}, nil
+		}
+	}
+	c.store("hot", cacheEntry{fetchedAt: now, uses: 1, fetch: fetcher("hot")})
+	c.store("cold", cacheEntry{fetchedAt: now, uses: 1, fetch: fetcher("cold")})
+	for i := 0; i < hotCacheUses; i++ {
+		c.load("hot")
+	}
+	c.load("cold")
+
+	c.refreshAhead(context.Background(), nil)
+	c.refreshes.Wait()
+	if len(fetched) != 0 {
+		t.Fatalf("expected no refresh long before the expiry, got %v", fetched)
+	}
+
+	now = now.Add(9 * time.Minute)
+	c.refreshAhead(context.Background(), func() bool { return false })
+	c.refreshes.Wait()
+	if len(fetched) != 0 {
+		t.Fatalf("expected no refresh without budget, got %v", fetched)
+	}
+
+	c.refreshAhead(context.Background(), func() bool { return true })
+	c.refreshes.Wait()
+	if !reflect.DeepEqual(fetched, []string{"hot"}) {
+		t.Fatalf("expected the hot response to be refreshed, got %v", fetched)
+	}
+	if entry := c.entries["hot"]; !entry.fetchedAt.Equal(now) || entry.recent != 0 || entry.uses != 1+hotCacheUses {
+		t.Fatalf("wrong refreshed entry: %+v", entry)
+	}
+	if stats := c.Stats(); stats != (CacheStats{Entries: 2, Hits: hotCacheUses + 1}) {
+		t.Fatalf("wrong cache stats: %+v", stats)
+	}
+}
+
+func TestCacheClose(t *testing.T) {
+	c := NewCache(time.Minute)
+	started := make(chan struct{})
+	c.refresh(context.Background(), "slow", func(ctx context.Context) (cacheEntry, error) {
+		close(started)
+		<-ctx.Done()
+		return cacheEntry{}, ctx.Err()
+	})
+	<-started
+
+	closed := make(chan struct{})
+	go func() {
+		c.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close didn't cancel the running refresh")
+	}
+
+	c.refresh(context.Background(), "late", func(ctx context.Context) (cacheEntry, error) {
+		t.Error("expected no refresh to start after Close")
+		return cacheEntry{}, nil
+	})
+	c.refreshes.Wait()
+	if stats := c.Stats(); stats.Refreshing != 0 || stats.Entries != 0 {
+		t.Fatalf("expected no refresh and no entry, got %+v", stats)
+	}
+
+	var nilCache *Cache
+	nilCache.Close()
+}
+
+func TestFindNearMisses(t *testing.T) {
+	graph := priceGraph(10)
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			// Later dates are cheaper, but none is below the low price.
+			price := 600 - float64(args.Date.Sub(graph[0].StartDate).Hours()/24)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: price}}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 500, High: 700}, nil
+		},
+	}
+
+	results, stats, err := Find(context.Background(), session, testArgs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no results, got %d", len(results))
+	}
+	var prices []float64
+	for _, miss := range stats.NearMisses {
+		if miss.LowPrice != 500 || miss.ShareableLink == "" {
+			t.Fatalf("incomplete near miss: %+v", miss)
+		}
+		prices = append(prices, miss.Price)
+	}
+	if !reflect.DeepEqual(prices, []float64{591, 592, 593}) {
+		t.Fatalf("expected the 3 cheapest misses, got %v", prices)
+	}
+}
+
+func TestFindPriceContext(t *testing.T) {
+	graph := priceGraph(3)
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			// Only the first date is below the low price.
+			price := 150 + 100*args.Date.Sub(graph[0].StartDate).Hours()/24
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: price}}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+
+	results, stats, err := Find(context.Background(), session, testArgs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || len(stats.PriceContext) != 3 {
+		t.Fatalf("expected 1 result and 3 dates of context, got %d and %d", len(results), len(stats.PriceContext))
+	}
+	for i, p := range stats.PriceContext {
+		if !p.StartDate.Equal(graph[i].StartDate) || p.Qualified != (i == 0) || p.LowPrice != 200 || p.HighPrice != 300 {
+			t.Fatalf("wrong context of date %d: %+v", i, p)
+		}
+	}
+}
+
+func TestFindMaxResults(t *testing.T) {
+	var calls atomic.Int32
+	session := &fakeSession{
+		graph: priceGraph(20),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			calls.Add(1)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	}
+	args := testArgs()
+	args.MaxResults = 3
+	args.MaxConcurrency = 1
+
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || !stats.StoppedEarly || stats.VerifiedDates != 3 {
+		t.Fatalf("expected to stop after 3 results, got %d results and %+v", len(results), stats)
+	}
+	// The first date searches the cities and then its airport pair, the others the
+	// pinned airport pair only.
+	if calls.Load() != 4 {
+		t.Fatalf("expected 4 searches for 3 dates, got %d", calls.Load())
+	}
+
+	args.MaxResults = -1
+	if _, _, err := Find(context.Background(), session, args); err == nil {
+		t.Fatal("expected an error for a negative maxResults")
+	}
+}
+
+func TestFindDeadline(t *testing.T) {
+	session := &fakeSession{
+		graph: priceGraph(20),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			time.Sleep(20 * time.Millisecond)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	}
+	args := testArgs()
+	args.MaxConcurrency = 1
+	start := time.Now()
+	args.Deadline = start.Add(200 * time.Millisecond)
+
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stats.DeadlineReached || stats.StoppedEarly || stats.VerifiedDates == 0 || stats.VerifiedDates >= 20 {
+		t.Fatalf("expected some but not all dates to be verified before the deadline, got %+v", stats)
+	}
+	if len(results) != stats.VerifiedDates {
+		t.Fatalf("expected a result per verified date, got %d results for %d dates", len(results), stats.VerifiedDates)
+	}
+	if elapsed := time.Since(start); elapsed > 400*time.Millisecond {
+		t.Fatalf("the search took %s, well past its deadline", elapsed)
+	}
+
+	args.Deadline = time.Now().Add(-time.Second)
+	results, stats, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 0 || stats.VerifiedDates != 0 || !stats.DeadlineReached {
+		t.Fatalf("expected no date to be verified past the deadline, got %d results and %+v", len(results), stats)
+	}
+}
+
+func TestFindDeadlineCancels(t *testing.T) {
+	graph := priceGraph(2)
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			// The second date hangs until it's canceled.
+			if args.Date.Equal(graph[1].StartDate) {
+				<-ctx.Done()
+				return nil, nil, ctx.Err()
+			}
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	}
+	args := testArgs()
+	start := time.Now()
+	args.Deadline = start.Add(100 * time.Millisecond)
+
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the search took %s, the hanging date wasn't canceled", elapsed)
+	}
+	if len(results) != 1 || !results[0].StartDate.Equal(graph[0].StartDate) {
+		t.Fatalf("expected the result of the first date, got %+v", results)
+	}
+	if !stats.DeadlineReached || stats.VerifiedDates != 1 || len(stats.Skipped) != 0 {
+		t.Fatalf("expected the canceled date to count as not verified rather than skipped, got %+v", stats)
+	}
+}
+
+func TestPlanDeadline(t *testing.T) {
+	now := time.Now()
+	args := testArgs()
+	args.SrcCities, args.DstCities = nil, nil
+	args.SrcAirports, args.DstAirports = []string{"SFO"}, []string{"JFK"}
+	args.MaxConcurrency = 4
+
+	for _, tc := range []struct {
+		name     string
+		deadline time.Duration
+		shallow  bool
+		dates    int
+		expected deadlinePlan
+	}{
+		// 10s leave 5s to plan on, 2.5 full verifications of 2s per slot.
+		{"fits fully", 10 * time.Second, false, 8, deadlinePlan{concurrency: 4}},
+		{"fewer slots", 10 * time.Second, false, 3, deadlinePlan{concurrency: 2}},
+		{"shallow", 10 * time.Second, false, 16, deadlinePlan{shallow: true, concurrency: 4}},
+		{"already shallow", 10 * time.Second, true, 8, deadlinePlan{concurrency: 2}},
+		{"nothing fits", time.Second, false, 8, deadlinePlan{shallow: true}},
+		{"no deadline", 0, false, 8, deadlinePlan{}},
+	} {
+		args.ShallowVerification = tc.shallow
+		args.Deadline = time.Time{}
+		if tc.deadline > 0 {
+			args.Deadline = now.Add(tc.deadline)
+		}
+		if plan := planDeadline(args, tc.dates, time.Second, now); plan != tc.expected {
+			t.Errorf("%s: expected %+v, got %+v", tc.name, tc.expected, plan)
+		}
+	}
+}
+
+func TestFindOriginPenalties(t *testing.T) {
+	graph := priceGraph(2)
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			offer := func(src string, price float64) flights.FullOffer {
+				return flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: price}, SrcAirportCode: src, DstAirportCode: "JFK"}
+			}
+			if args.Date.Equal(graph[0].StartDate) {
+				return []flights.FullOffer{offer("HAM", 50), offer("BER", 100)}, &flights.PriceRange{Low: 200, High: 300}, nil
+			}
+			return []flights.FullOffer{offer("HAM", 60)}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+	args := testArgs()
+
+	args.OriginPenalties = map[string]float64{"HAM": 80}
+	results, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The home airport wins the first date, the alternative saves less than 80.
+	// The only offer of the second date ranks with its penalty.
+	if len(results) != 2 || results[0].SrcAirport != "BER" || results[0].Price != 100 ||
+		results[1].SrcAirport != "HAM" || results[1].Price != 60 || results[1].Penalty != 80 {
+		t.Fatalf("expected BER for 100 before HAM for 60 with a penalty of 80, got %+v", results)
+	}
+
+	args.OriginPenalties = map[string]float64{"HAM": 40}
+	results, _, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].SrcAirport != "HAM" || results[0].Price != 50 {
+		t.Fatalf("expected HAM to win once it saves more than its penalty, got %+v", results)
+	}
+
+	args.OriginPenalties = map[string]float64{"HAM": -1}
+	if _, _, err := Find(context.Background(), session, args); err == nil {
+		t.Fatal("expected an error for a negative penalty")
+	}
+}
+
+func TestFindDerankSelfTransfers(t *testing.T) {
+	graph := priceGraph(2)
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			offer := func(price float64, connection string) flights.FullOffer {
+				return flights.FullOffer{
+					Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: price},
+					Flight: []flights.Flight{
+						{DepAirportCode: "SFO", ArrAirportCode: "ORD", FlightNumber: "UA 1"},
+						{DepAirportCode: connection, ArrAirportCode: "JFK", FlightNumber: "UA 2"},
+					},
+					SrcAirportCode: "SFO",
+					DstAirportCode: "JFK",
+				}
+			}
+			if args.Date.Equal(graph[0].StartDate) {
+				return []flights.FullOffer{offer(50, "MDW"), offer(100, "ORD")}, &flights.PriceRange{Low: 200, High: 300}, nil
+			}
+			return []flights.FullOffer{offer(60, "MDW")}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+	args := testArgs()
+
+	results, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Price != 50 || !results[0].SelfTransfer {
+		t.Fatalf("expected the cheapest offers flagged, got %+v", results)
+	}
+
+	args.DerankSelfTransfers = true
+	results, _, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first date picks its offer without the airport change, the second date
+	// only has one and ranks last despite its price.
+	if len(results) != 2 || results[0].Price != 100 || results[0].SelfTransfer || results[1].Price != 60 || !results[1].SelfTransfer {
+		t.Fatalf("expected the self-transfer ranked last, got %+v", results)
+	}
+
+	args.DerankSelfTransfers = false
+	args.Filters.ExcludeSelfTransfers = true
+	results, _, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Price != 100 {
+		t.Fatalf("expected only the offer without airport change, got %+v", results)
+	}
+}
+
+func TestOfferID(t *testing.T) {
+	warsaw := time.FixedZone("CET", 3600)
+	departure := time.Date(2030, 4, 1, 7, 30, 0, 0, warsaw)
+	returnDate := time.Date(2030, 4, 8, 0, 0, 0, 0, time.UTC)
+	id := OfferID(departure, returnDate, "WAW", "ATH", []string{"LO 1"})
+
+	if other := OfferID(time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC), returnDate, "WAW", "ATH", []string{"LO 1"}); other != id {
+		t.Fatalf("expected the day of the departure to identify it, got %s and %s", id, other)
+	}
+	for name, other := range map[string]string{
+		"start date":     OfferID(departure.AddDate(0, 0, 1), returnDate, "WAW", "ATH", []string{"LO 1"}),
+		"return date":    OfferID(departure, returnDate.AddDate(0, 0, 1), "WAW", "ATH", []string{"LO 1"}),
+		"airports":       OfferID(departure, returnDate, "WMI", "ATH", []string{"LO 1"}),
+		"flight numbers": OfferID(departure, returnDate, "WAW", "ATH", []string{"LO 3"}),
+	} {
+		if other == id {
+			t.Errorf("expected another %s to change the ID", name)
+		}
+	}
+}
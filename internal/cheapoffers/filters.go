@@ -0,0 +1,176 @@
+package cheapoffers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+	"github.com/krisukox/google-flights-api/iata"
+)
+
+// Filters are constraints on the offers a search reports. Offers that don't match
+// are ignored when picking the cheapest offer of a date. The zero value matches
+// every offer.
+type Filters struct {
+	MaxPrice         float64       // 0 means no limit
+	MaxTotalDuration time.Duration // of the outbound trip including layovers, 0 means no limit
+
+	// IncludeAirlines, if not empty, only matches offers whose outbound flights are
+	// all operated by one of these airlines. ExcludeAirlines never matches offers
+	// with an outbound flight by one of these airlines. Airlines are given by name or
+	// IATA code, ignoring case.
+	IncludeAirlines []string
+	ExcludeAirlines []string
+
+	// EarliestDeparture and LatestDeparture bound the local time of day of the
+	// outbound departure, as offsets from midnight. LatestDeparture 0 means no bound.
+	EarliestDeparture time.Duration
+	LatestDeparture   time.Duration
+
+	// AvoidCountries never matches offers with an outbound layover in one of these
+	// countries, given by ISO 3166-1 alpha-2 code. Layovers at airports of unknown
+	// country match.
+	AvoidCountries []string
+
+	// ExcludeOvernightLayovers never matches offers with an outbound layover through
+	// the local night, see OvernightLayover.
+	ExcludeOvernightLayovers bool
+
+	// ExcludeSelfTransfers never matches offers with self-transfer risk, see
+	// SelfTransferRisk.
+	ExcludeSelfTransfers bool
+}
+
+// Validate checks that the limits aren't negative and the departure window is a
+// time of day.
+func (f Filters) Validate() error {
+	if f.MaxPrice < 0 {
+		return fmt.Errorf("maxPrice must not be negative")
+	}
+	if f.MaxTotalDuration < 0 {
+		return fmt.Errorf("maxTotalDuration must not be negative")
+	}
+	day := 24 * time.Hour
+	if f.EarliestDeparture < 0 || f.EarliestDeparture >= day || f.LatestDeparture < 0 || f.LatestDeparture >= day {
+		return fmt.Errorf("departure times must be between 00:00 and 23:59")
+	}
+	if f.LatestDeparture > 0 && f.LatestDeparture < f.EarliestDeparture {
+		return fmt.Errorf("the latest departure must not be before the earliest departure")
+	}
+	for _, code := range f.AvoidCountries {
+		if iata.CountryName(code) == "" {
+			return fmt.Errorf("unknown country code %q", code)
+		}
+	}
+	return nil
+}
+
+// Match reports whether offer satisfies the filters.
+func (f Filters) Match(offer flights.FullOffer) bool {
+	if f.MaxPrice > 0 && offer.Price > f.MaxPrice {
+		return false
+	}
+	if f.MaxTotalDuration > 0 && offer.FlightDuration > f.MaxTotalDuration {
+		return false
+	}
+	for _, flight := range offer.Flight {
+		if len(f.IncludeAirlines) > 0 && !operatedBy(flight, f.IncludeAirlines) {
+			return false
+		}
+		if operatedBy(flight, f.ExcludeAirlines) {
+			return false
+		}
+	}
+	if f.EarliestDeparture > 0 || f.LatestDeparture > 0 {
+		departure := offer.StartDate
+		if len(offer.Flight) > 0 {
+			departure = offer.Flight[0].DepTime
+		}
+		timeOfDay := time.Duration(departure.Hour())*time.Hour + time.Duration(departure.Minute())*time.Minute
+		if timeOfDay < f.EarliestDeparture || (f.LatestDeparture > 0 && timeOfDay > f.LatestDeparture) {
+			return false
+		}
+	}
+	if f.ExcludeOvernightLayovers && OvernightLayover(offer) {
+		return false
+	}
+	if f.ExcludeSelfTransfers && SelfTransferRisk(offer) {
+		return false
+	}
+	if len(f.AvoidCountries) > 0 {
+		// A layover may change airports, both count.
+		for i := 0; i+1 < len(offer.Flight); i++ {
+			for _, airport := range []string{offer.Flight[i].ArrAirportCode, offer.Flight[i+1].DepAirportCode} {
+				if slices.Contains(f.AvoidCountries, iata.Country(airport)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// The local night at a layover airport, as offsets from midnight, and how much of
+// it a layover has to cover to count as overnight.
+const (
+	nightStart          = 0
+	nightEnd            = 5 * time.Hour
+	minOvernightOverlap = time.Hour
+)
+
+// OvernightLayover reports whether one of the outbound layovers of offer covers at
+// least an hour of the local night, midnight to 5am at the layover airport.
+func OvernightLayover(offer flights.FullOffer) bool {
+	for i := 0; i+1 < len(offer.Flight); i++ {
+		arrival, departure := offer.Flight[i].ArrTime, offer.Flight[i+1].DepTime
+		if nightOverlap(arrival, departure) >= minOvernightOverlap {
+			return true
+		}
+	}
+	return false
+}
+
+// SelfTransferRisk reports whether an outbound connection of offer arrives at one
+// airport and departs from another. Such connections are usually sold as separate
+// tickets: the traveler has to collect the bags and check in again, and a delay
+// of the first flight doesn't protect the second. Google Flights doesn't report
+// the tickets of an offer, so self-transfers at the same airport go undetected.
+func SelfTransferRisk(offer flights.FullOffer) bool {
+	for i := 0; i+1 < len(offer.Flight); i++ {
+		if offer.Flight[i].ArrAirportCode != offer.Flight[i+1].DepAirportCode {
+			return true
+		}
+	}
+	return false
+}
+
+// nightOverlap returns how much of the local nights of from's time zone lie between
+// from and to.
+func nightOverlap(from, to time.Time) time.Duration {
+	var overlap time.Duration
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		start, end := day.Add(nightStart), day.Add(nightEnd)
+		if from.After(start) {
+			start = from
+		}
+		if to.Before(end) {
+			end = to
+		}
+		if end.After(start) {
+			overlap += end.Sub(start)
+		}
+	}
+	return overlap
+}
+
+// operatedBy reports whether the flight's airline name or the carrier code of its
+// flight number is one of airlines.
+func operatedBy(flight flights.Flight, airlines []string) bool {
+	code, _, _ := strings.Cut(flight.FlightNumber, " ")
+	return slices.ContainsFunc(airlines, func(airline string) bool {
+		return strings.EqualFold(airline, flight.AirlineName) || strings.EqualFold(airline, code)
+	})
+}
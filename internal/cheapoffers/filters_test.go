@@ -0,0 +1,149 @@
+package cheapoffers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func filterOffer(price float64, departure time.Time, duration time.Duration, flightNumbers ...string) flights.FullOffer {
+	offer := flights.FullOffer{
+		Offer:          flights.Offer{StartDate: departure, ReturnDate: departure.AddDate(0, 0, 7), Price: price},
+		FlightDuration: duration,
+	}
+	for _, number := range flightNumbers {
+		airline := map[string]string{"UA": "United", "LH": "Lufthansa", "NK": "Spirit"}[number[:2]]
+		offer.Flight = append(offer.Flight, flights.Flight{FlightNumber: number, AirlineName: airline, DepTime: departure})
+	}
+	return offer
+}
+
+func TestFiltersMatch(t *testing.T) {
+	morning := time.Date(2030, 5, 1, 8, 30, 0, 0, time.UTC)
+	night := time.Date(2030, 5, 1, 3, 0, 0, 0, time.UTC)
+	filters := Filters{
+		MaxPrice:          500,
+		MaxTotalDuration:  12 * time.Hour,
+		IncludeAirlines:   []string{"united", "LH"},
+		ExcludeAirlines:   []string{"Spirit"},
+		EarliestDeparture: 6 * time.Hour,
+		LatestDeparture:   22 * time.Hour,
+	}
+	tests := []struct {
+		name  string
+		offer flights.FullOffer
+		match bool
+	}{
+		{"matching", filterOffer(400, morning, 10*time.Hour, "UA 58", "LH 190"), true},
+		{"too expensive", filterOffer(600, morning, 10*time.Hour, "UA 58"), false},
+		{"too long", filterOffer(400, morning, 20*time.Hour, "UA 58"), false},
+		{"other airline", filterOffer(400, morning, 10*time.Hour, "UA 58", "NK 12"), false},
+		{"too early", filterOffer(400, night, 10*time.Hour, "UA 58"), false},
+	}
+	for _, tt := range tests {
+		if match := filters.Match(tt.offer); match != tt.match {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.match, match)
+		}
+	}
+	if !(Filters{}).Match(filterOffer(9999, night, 48*time.Hour, "NK 12")) {
+		t.Fatal("expected the zero filters to match every offer")
+	}
+	if err := (Filters{EarliestDeparture: 20 * time.Hour, LatestDeparture: 6 * time.Hour}).Validate(); err == nil {
+		t.Fatal("expected an error for an empty departure window")
+	}
+}
+
+func TestFiltersAvoidCountries(t *testing.T) {
+	route := func(airports ...string) flights.FullOffer {
+		var offer flights.FullOffer
+		for i := 0; i+1 < len(airports); i++ {
+			offer.Flight = append(offer.Flight, flights.Flight{DepAirportCode: airports[i], ArrAirportCode: airports[i+1]})
+		}
+		return offer
+	}
+	filters := Filters{AvoidCountries: []string{"RU", "TR"}}
+	if err := filters.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		offer flights.FullOffer
+		match bool
+	}{
+		{"nonstop into an avoided country", route("FRA", "SVO"), true},
+		{"layover elsewhere", route("WAW", "FRA", "JFK"), true},
+		{"layover in an avoided country", route("WAW", "IST", "JFK"), false},
+		{"second layover in an avoided country", route("WAW", "FRA", "SVO", "PEK"), false},
+	}
+	for _, tt := range tests {
+		if match := filters.Match(tt.offer); match != tt.match {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.match, match)
+		}
+	}
+	if err := (Filters{AvoidCountries: []string{"Russia"}}).Validate(); err == nil {
+		t.Fatal("expected an error for a country name instead of a code")
+	}
+}
+
+func TestOvernightLayover(t *testing.T) {
+	// Times are local to the layover airport.
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	layover := func(arrival, departure time.Time) flights.FullOffer {
+		return flights.FullOffer{Flight: []flights.Flight{{ArrTime: arrival}, {DepTime: departure}}}
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2030, 5, day, hour, minute, 0, 0, tokyo)
+	}
+	tests := []struct {
+		name      string
+		offer     flights.FullOffer
+		overnight bool
+	}{
+		{"daytime", layover(at(1, 10, 0), at(1, 14, 0)), false},
+		{"short connection at midnight", layover(at(1, 23, 30), at(2, 0, 30)), false},
+		{"through the night", layover(at(1, 22, 0), at(2, 7, 0)), true},
+		{"early morning", layover(at(2, 3, 0), at(2, 6, 0)), true},
+		{"all day", layover(at(1, 6, 0), at(2, 6, 0)), true},
+		{"nonstop", flights.FullOffer{Flight: []flights.Flight{{ArrTime: at(2, 3, 0)}}}, false},
+	}
+	for _, tt := range tests {
+		if overnight := OvernightLayover(tt.offer); overnight != tt.overnight {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.overnight, overnight)
+		}
+		if match := (Filters{ExcludeOvernightLayovers: true}).Match(tt.offer); match == tt.overnight {
+			t.Errorf("%s: expected the filter to match %v, got %v", tt.name, !tt.overnight, match)
+		}
+	}
+}
+
+func TestFindFilters(t *testing.T) {
+	session := &fakeSession{
+		graph: priceGraph(1),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			night := args.Date.Add(3 * time.Hour)
+			morning := args.Date.Add(9 * time.Hour)
+			return []flights.FullOffer{
+				filterOffer(100, night, 8*time.Hour, "UA 1"),
+				filterOffer(150, morning, 8*time.Hour, "UA 2"),
+			}, &flights.PriceRange{Low: 200, High: 400}, nil
+		},
+	}
+	args := testArgs()
+	args.Filters = Filters{EarliestDeparture: 6 * time.Hour}
+
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Price != 150 {
+		t.Fatalf("expected the cheapest morning offer, got %+v", results)
+	}
+	if stats.Filtered != 1 {
+		t.Fatalf("expected 1 filtered offer, got %d", stats.Filtered)
+	}
+}
@@ -0,0 +1,173 @@
+package cheapoffers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+)
+
+// TopKResults is the number of results a search keeps once the results of all
+// searches exceed the memory ceiling of their Guardrails, unless Args.MaxResults
+// asks for fewer.
+const TopKResults = 20
+
+// resultOverhead approximates the memory of a Result without its strings.
+const resultOverhead = 320
+
+// Guardrails bound the goroutines verifying dates and the memory of the results
+// buffered by all searches sharing them. Instead of exceeding them, a search
+// verifies fewer dates at a time and keeps only its cheapest results; Stats.Degraded
+// says so. A nil *Guardrails doesn't bound anything.
+type Guardrails struct {
+	slots            chan struct{} // a token per goroutine verifying a date, nil for no limit
+	maxBufferedBytes int64         // 0 for no limit
+	buffered         atomic.Int64
+}
+
+// NewGuardrails returns guardrails allowing maxGoroutines goroutines verifying
+// dates and approximately maxBufferedBytes of buffered results across searches.
+// Zero disables a limit.
+func NewGuardrails(maxGoroutines int, maxBufferedBytes int64) *Guardrails {
+	g := &Guardrails{maxBufferedBytes: maxBufferedBytes}
+	if maxGoroutines > 0 {
+		g.slots = make(chan struct{}, maxGoroutines)
+	}
+	return g
+}
+
+// Goroutines returns the number of goroutines verifying dates right now.
+func (g *Guardrails) Goroutines() int {
+	if g == nil {
+		return 0
+	}
+	return len(g.slots)
+}
+
+// BufferedBytes returns the approximate memory of the results buffered right now.
+func (g *Guardrails) BufferedBytes() int64 {
+	if g == nil {
+		return 0
+	}
+	return g.buffered.Load()
+}
+
+// reserve returns how many of the want goroutines a search may verify dates with,
+// at least one. It waits for the first one until ctx is done.
+func (g *Guardrails) reserve(ctx context.Context, want int) (int, error) {
+	if g == nil || g.slots == nil {
+		return want, nil
+	}
+	select {
+	case g.slots <- struct{}{}:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	got := 1
+	for got < want {
+		select {
+		case g.slots <- struct{}{}:
+			got++
+		default:
+			return got, nil
+		}
+	}
+	return got, nil
+}
+
+// release returns n goroutines taken by reserve.
+func (g *Guardrails) release(n int) {
+	if g == nil || g.slots == nil {
+		return
+	}
+	for range n {
+		<-g.slots
+	}
+}
+
+// buffer accounts n more bytes of results and reports whether all searches stay
+// below the memory ceiling.
+func (g *Guardrails) buffer(n int64) bool {
+	if g == nil {
+		return true
+	}
+	total := g.buffered.Add(n)
+	return g.maxBufferedBytes == 0 || total <= g.maxBufferedBytes
+}
+
+// unbuffer releases n bytes accounted by buffer.
+func (g *Guardrails) unbuffer(n int64) {
+	if g != nil {
+		g.buffered.Add(-n)
+	}
+}
+
+// resultsSize approximates the memory of results.
+func resultsSize(results []Result) int64 {
+	var size int64
+	for _, r := range results {
+		size += resultOverhead + int64(len(r.SrcAirport)+len(r.DstAirport)+len(r.ShareableLink)+len(r.LinkError))
+		for _, n := range r.FlightNumbers {
+			size += 16 + int64(len(n))
+		}
+	}
+	return size
+}
+
+// topK sorts results and keeps the k best ones.
+func topK(results []Result, k int, args Args) []Result {
+	sort.Slice(results, func(i, j int) bool {
+		return lessResult(results[i], results[j], args)
+	})
+	if len(results) <= k {
+		return results
+	}
+	// A new slice, so that the memory of the others is released.
+	return append([]Result(nil), results[:k]...)
+}
+
+// retention is the part of the results of a search held against the memory
+// ceiling of its guardrails.
+type retention struct {
+	guardrails *Guardrails
+	held       int64 // bytes accounted to the guardrails
+	trimmed    bool  // the results were cut down to the top K
+}
+
+// add accounts the results of a batch. Above the memory ceiling, it cuts results
+// down to the top K and misses down to the near misses.
+func (r *retention) add(args Args, results, misses []Result, batchResults, batchMisses []Result) ([]Result, []Result) {
+	results = append(results, batchResults...)
+	misses = append(misses, batchMisses...)
+	n := resultsSize(batchResults) + resultsSize(batchMisses)
+	r.held += n
+	if r.guardrails.buffer(n) {
+		return results, misses
+	}
+
+	k := TopKResults
+	if args.MaxResults > 0 {
+		k = min(k, args.MaxResults)
+	}
+	results = topK(results, k, args)
+	misses = topK(misses, maxNearMisses, args)
+	kept := resultsSize(results) + resultsSize(misses)
+	r.guardrails.unbuffer(r.held - kept)
+	r.held = kept
+	r.trimmed = true
+	return results, misses
+}
+
+// release gives the held bytes back to the guardrails.
+func (r *retention) release() {
+	r.guardrails.unbuffer(r.held)
+	r.held = 0
+}
+
+// degradedFanOut describes a search verifying fewer dates at a time than asked for.
+func degradedFanOut(got, want int) string {
+	return fmt.Sprintf("verified %d instead of %d dates at a time, the server is busy", got, want)
+}
+
+// degradedRetention describes a search that kept only its cheapest results.
+const degradedRetention = "kept only the cheapest results and near misses, the server is low on memory"
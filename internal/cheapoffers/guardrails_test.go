@@ -0,0 +1,61 @@
+package cheapoffers
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestFindGuardrails(t *testing.T) {
+	var active, peak atomic.Int32
+	session := &fakeSession{
+		graph: priceGraph(30),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			n := active.Add(1)
+			defer active.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			offer := flights.FullOffer{Offer: flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 50}, SrcAirportCode: "SFO", DstAirportCode: "JFK"}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 100, High: 200}, nil
+		},
+	}
+
+	guardrails := NewGuardrails(2, 1)
+	args := testArgs()
+	args.Guardrails = guardrails
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", p)
+	}
+	if len(results) != TopKResults {
+		t.Fatalf("expected the top %d results, got %d", TopKResults, len(results))
+	}
+	if degraded := strings.Join(stats.Degraded, "\n"); !strings.Contains(degraded, "2 instead of 8") || !strings.Contains(degraded, "cheapest results") {
+		t.Fatalf("wrong degradations: %q", stats.Degraded)
+	}
+	if guardrails.Goroutines() != 0 || guardrails.BufferedBytes() != 0 {
+		t.Fatalf("the search didn't release its guardrails: %d goroutines, %d bytes", guardrails.Goroutines(), guardrails.BufferedBytes())
+	}
+
+	// Without limits nothing degrades.
+	args.Guardrails = NewGuardrails(0, 0)
+	results, stats, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 30 || len(stats.Degraded) != 0 {
+		t.Fatalf("expected 30 results without degradation, got %d and %q", len(results), stats.Degraded)
+	}
+}
@@ -0,0 +1,53 @@
+package cheapoffers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// Preferences trade the properties of an offer against its price when choosing the
+// best offer of a date and when ranking results, like Args.OriginPenalties. The
+// weights are amounts of the search currency, e.g. PerStop 200 prefers a nonstop
+// offer unless a connection saves more than 200.
+type Preferences struct {
+	PerStop float64 // per outbound stop
+	PerHour float64 // per hour of the outbound trip including layovers
+
+	// PreferredDeparture is the preferred local time of day of the outbound
+	// departure as an offset from midnight, PerDepartureHour the weight of every
+	// hour the departure is away from it.
+	PreferredDeparture time.Duration
+	PerDepartureHour   float64
+}
+
+// Validate checks that the weights aren't negative and the preferred departure is
+// a time of day.
+func (p Preferences) Validate() error {
+	if p.PerStop < 0 || p.PerHour < 0 || p.PerDepartureHour < 0 {
+		return fmt.Errorf("preference weights must not be negative")
+	}
+	if p.PreferredDeparture < 0 || p.PreferredDeparture >= 24*time.Hour {
+		return fmt.Errorf("the preferred departure must be between 00:00 and 23:59")
+	}
+	return nil
+}
+
+// Penalty returns the amount added to the price of offer by the preferences.
+func (p Preferences) Penalty(offer flights.FullOffer) float64 {
+	var penalty float64
+	if stops := len(offer.Flight) - 1; stops > 0 {
+		penalty += p.PerStop * float64(stops)
+	}
+	penalty += p.PerHour * offer.FlightDuration.Hours()
+	if p.PerDepartureHour > 0 && len(offer.Flight) > 0 {
+		departure := offer.Flight[0].DepTime
+		timeOfDay := time.Duration(departure.Hour())*time.Hour + time.Duration(departure.Minute())*time.Minute
+		away := (timeOfDay - p.PreferredDeparture).Abs()
+		// The distance wraps around midnight: 23:00 is an hour away from 00:00.
+		away = min(away, 24*time.Hour-away)
+		penalty += p.PerDepartureHour * away.Hours()
+	}
+	return penalty
+}
@@ -0,0 +1,39 @@
+package cheapoffers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestPreferencesPenalty(t *testing.T) {
+	morning := time.Date(2030, 5, 1, 8, 0, 0, 0, time.UTC)
+	lateNight := time.Date(2030, 5, 1, 23, 0, 0, 0, time.UTC)
+	preferences := Preferences{PerStop: 200, PerHour: 10, PreferredDeparture: time.Hour, PerDepartureHour: 5}
+	tests := []struct {
+		name    string
+		offer   flights.FullOffer
+		penalty float64
+	}{
+		{"nonstop", filterOffer(400, morning, 10*time.Hour, "UA 58"), 100 + 7*5},
+		{"one stop", filterOffer(400, morning, 10*time.Hour, "UA 58", "LH 190"), 200 + 100 + 7*5},
+		{"across midnight", filterOffer(400, lateNight, 2*time.Hour, "UA 58"), 20 + 2*5},
+	}
+	for _, tt := range tests {
+		if penalty := preferences.Penalty(tt.offer); penalty != tt.penalty {
+			t.Errorf("%s: expected a penalty of %v, got %v", tt.name, tt.penalty, penalty)
+		}
+	}
+	if penalty := (Preferences{}).Penalty(filterOffer(400, morning, 10*time.Hour, "UA 58", "LH 190")); penalty != 0 {
+		t.Errorf("expected no penalty without preferences, got %v", penalty)
+	}
+}
+
+func TestPreferencesValidate(t *testing.T) {
+	for _, p := range []Preferences{{PerStop: -1}, {PerHour: -1}, {PerDepartureHour: -1}, {PreferredDeparture: 24 * time.Hour}} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected an error for %+v", p)
+		}
+	}
+}
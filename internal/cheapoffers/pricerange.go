@@ -0,0 +1,154 @@
+package cheapoffers
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// priceRanges looks up Google's price range of the airport pair and dates of a
+// search's best offers. Lookups of the same airport pair and dates share one upstream
+// request, whether they run at the same time or one after the other, and a date
+// searched by that airport pair in the first place needs no request at all.
+//
+// It also pins the airport pair of the cheapest best offer found, so that the later
+// dates of a search over several airport pairs can search that pair in the first
+// place and need a single request.
+type priceRanges struct {
+	session *meteredSession
+	cache   *Cache
+	options flights.Options
+
+	mu          sync.Mutex
+	calls       map[pairKey]*priceRangeCall
+	pinned      pairKey // only src and dst are set, empty until a pair is pinned
+	pinnedPrice float64 // ranking price of the offer that pinned the pair
+}
+
+// pairKey identifies the search of an airport pair on the dates of an offer. The
+// dates are formatted, as offers carry their departure time.
+type pairKey struct {
+	src, dst, date, returnDate string
+}
+
+type priceRangeCall struct {
+	done   chan struct{} // closed when search and err are set
+	search pairSearch
+	err    error
+}
+
+// pairSearch is the response of an airport pair search.
+type pairSearch struct {
+	offers     []flights.FullOffer
+	priceRange *flights.PriceRange
+	fetchedAt  time.Time
+	cached     bool // answered by Args.Cache
+}
+
+func newPriceRanges(session *meteredSession, args Args) *priceRanges {
+	return &priceRanges{session: session, cache: args.Cache, options: args.Options, calls: map[pairKey]*priceRangeCall{}}
+}
+
+func offerPairKey(offer flights.FullOffer) pairKey {
+	return pairKey{
+		src:        offer.SrcAirportCode,
+		dst:        offer.DstAirportCode,
+		date:       offer.StartDate.Format(time.DateOnly),
+		returnDate: offer.ReturnDate.Format(time.DateOnly),
+	}
+}
+
+// seed remembers the response of a search that already was an airport pair search
+// of the offer's dates.
+func (p *priceRanges) seed(searched flights.Args, offer flights.FullOffer, search pairSearch) {
+	if len(searched.SrcCities) > 0 || len(searched.DstCities) > 0 ||
+		!slices.Equal(searched.SrcAirports, []string{offer.SrcAirportCode}) ||
+		!slices.Equal(searched.DstAirports, []string{offer.DstAirportCode}) {
+		return
+	}
+	call := &priceRangeCall{done: make(chan struct{}), search: search}
+	close(call.done)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.calls[offerPairKey(offer)]; !ok {
+		p.calls[offerPairKey(offer)] = call
+	}
+}
+
+// get returns the price range of the offer's airport pair and dates.
+func (p *priceRanges) get(ctx context.Context, offer flights.FullOffer) (*flights.PriceRange, error) {
+	search, err := p.search(ctx, offerPairKey(offer), offer.StartDate, offer.ReturnDate)
+	return search.priceRange, err
+}
+
+// search searches the airport pair and dates of key, unless a search of them has
+// already been started.
+func (p *priceRanges) search(ctx context.Context, key pairKey, date, returnDate time.Time) (pairSearch, error) {
+	p.mu.Lock()
+	call, ok := p.calls[key]
+	if !ok {
+		call = &priceRangeCall{done: make(chan struct{})}
+		p.calls[key] = call
+	}
+	p.mu.Unlock()
+
+	if ok {
+		select {
+		case <-call.done:
+			return call.search, call.err
+		case <-ctx.Done():
+			return pairSearch{}, ctx.Err()
+		}
+	}
+
+	s := &call.search
+	s.offers, s.priceRange, s.fetchedAt, s.cached, call.err = p.cache.getOffers(
+		ctx,
+		p.session,
+		flights.Args{
+			Date:        date,
+			ReturnDate:  returnDate,
+			SrcAirports: []string{key.src},
+			DstAirports: []string{key.dst},
+			Options:     p.options,
+		},
+	)
+	p.session.cached(s.cached)
+	close(call.done)
+	return call.search, call.err
+}
+
+// pin pins the airport pair of a date's best offer if its ranking price is the
+// cheapest so far.
+func (p *priceRanges) pin(offer flights.FullOffer, price float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pinned.src == "" || price < p.pinnedPrice {
+		p.pinned = pairKey{src: offer.SrcAirportCode, dst: offer.DstAirportCode}
+		p.pinnedPrice = price
+	}
+}
+
+// searchPinned searches the pinned airport pair on the dates of a price graph offer,
+// ok is false until a pair is pinned.
+func (p *priceRanges) searchPinned(ctx context.Context, offer flights.Offer) (search pairSearch, ok bool, err error) {
+	p.mu.Lock()
+	key := p.pinned
+	p.mu.Unlock()
+	if key.src == "" {
+		return pairSearch{}, false, nil
+	}
+	key.date, key.returnDate = offer.StartDate.Format(time.DateOnly), offer.ReturnDate.Format(time.DateOnly)
+	search, err = p.search(ctx, key, offer.StartDate, offer.ReturnDate)
+	return search, true, err
+}
+
+// severalPairs reports whether the search covers more than one airport pair, so that
+// verifying its best offers takes an airport pair search of their own.
+func severalPairs(args Args) bool {
+	return len(args.SrcCities) > 0 || len(args.DstCities) > 0 || len(args.SrcAirports) > 1 || len(args.DstAirports) > 1
+}
@@ -0,0 +1,193 @@
+package cheapoffers
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// countingSession answers every search with one offer between SFO and JFK and
+// counts the searches by airport pair.
+func countingSession(graph []flights.Offer) (*fakeSession, func() (city, pair int)) {
+	var (
+		mu         sync.Mutex
+		city, pair int
+	)
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if len(args.SrcCities) > 0 {
+				city++
+			} else {
+				pair++
+			}
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+	return session, func() (int, int) {
+		mu.Lock()
+		defer mu.Unlock()
+		return city, pair
+	}
+}
+
+func TestFindSharesPairSearches(t *testing.T) {
+	// The same dates twice, e.g. reported by overlapping price graphs.
+	graph := priceGraph(3)
+	graph = append(graph, graph...)
+	session, counts := countingSession(graph)
+	args := testArgs()
+	args.MaxConcurrency = 1
+
+	results, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 6 {
+		t.Fatalf("expected a result per price graph date, got %d", len(results))
+	}
+	// The first date pins the airport pair of its best offer, the others search that
+	// pair once per date.
+	if city, pair := counts(); city != 1 || pair != 3 {
+		t.Fatalf("expected a city search and 3 airport pair searches, got %d and %d", city, pair)
+	}
+}
+
+func TestFindPinnedPairFallback(t *testing.T) {
+	graph := priceGraph(3)
+	noPair := graph[1].StartDate
+
+	var mu sync.Mutex
+	var city int
+	session := &fakeSession{
+		graph: graph,
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			src := "SFO"
+			if len(args.SrcCities) > 0 {
+				city++
+				if args.Date.Equal(noPair) {
+					src = "OAK"
+				}
+			} else if args.Date.Equal(noPair) && args.SrcAirports[0] == "SFO" {
+				// The pinned pair has no flights on this date.
+				return nil, &flights.PriceRange{Low: 200, High: 300}, nil
+			}
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100},
+				SrcAirportCode: src,
+				DstAirportCode: "JFK",
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+	args := testArgs()
+	args.MaxConcurrency = 1
+
+	results, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected a result per date, got %+v", results)
+	}
+	for _, r := range results {
+		if r.StartDate.Equal(noPair) && r.SrcAirport != "OAK" {
+			t.Fatalf("expected the date without the pinned pair to find OAK, got %+v", r)
+		}
+	}
+	if city != 2 {
+		t.Fatalf("expected the first date and the date without the pinned pair to search the cities, got %d city searches", city)
+	}
+}
+
+func TestFindPinnedPairFallbackMaxRequests(t *testing.T) {
+	session := &fakeSession{
+		graph: priceGraph(10),
+		getOffers: func(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+			// The pinned pair has no flights after the first date, which takes a
+			// pinned search and both searches of the fallback.
+			if len(args.SrcCities) == 0 && args.SrcAirports[0] == "SFO" && !args.Date.Equal(priceGraph(1)[0].StartDate) {
+				return nil, &flights.PriceRange{Low: 200, High: 300}, nil
+			}
+			offer := flights.FullOffer{
+				Offer:          flights.Offer{StartDate: args.Date, ReturnDate: args.ReturnDate, Price: 100},
+				SrcAirportCode: "SFO",
+				DstAirportCode: "JFK",
+			}
+			if !args.Date.Equal(priceGraph(1)[0].StartDate) {
+				offer.SrcAirportCode = "OAK"
+			}
+			return []flights.FullOffer{offer}, &flights.PriceRange{Low: 200, High: 300}, nil
+		},
+	}
+	args := testArgs()
+	args.MaxConcurrency = 1
+	args.MaxRequests = 1 + 3*pinnedRequestsPerDate
+
+	results, stats, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 verified dates, got %+v", results)
+	}
+	// The links of the results are serialized outside of MaxRequests.
+	if stats.Requests.Upstream()-stats.Requests.Links > args.MaxRequests {
+		t.Fatalf("expected at most %d requests, got %+v", args.MaxRequests, stats.Requests)
+	}
+}
+
+func TestFindReusesAirportPairSearch(t *testing.T) {
+	session, counts := countingSession(priceGraph(3))
+	args := testArgs()
+	args.SrcCities, args.SrcAirports = nil, []string{"SFO"}
+	args.DstCities, args.DstAirports = nil, []string{"JFK"}
+
+	results, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || results[0].LowPrice != 200 {
+		t.Fatalf("expected 3 results with the price range, got %+v", results)
+	}
+	if _, pair := counts(); pair != 3 {
+		t.Fatalf("expected a single search per date, got %d", pair)
+	}
+}
+
+func TestFindShallowVerification(t *testing.T) {
+	session, counts := countingSession(priceGraph(3))
+	args := testArgs()
+	args.ShallowVerification = true
+
+	results, _, err := Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || results[0].LowPrice != 200 {
+		t.Fatalf("expected 3 results with the price range of the city search, got %+v", results)
+	}
+	if city, pair := counts(); city != 3 || pair != 0 {
+		t.Fatalf("expected only the 3 city searches, got %d and %d airport pair searches", city, pair)
+	}
+
+	args.MaxRequests = 1 + 2
+	results, _, err = Find(context.Background(), session, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected a date per request within maxRequests, got %d results", len(results))
+	}
+}
@@ -0,0 +1,71 @@
+package cheapoffers
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Progress tracks the price graph dates of a running search. A nil *Progress
+// ignores all updates. It is safe for concurrent use by multiple goroutines.
+type Progress struct {
+	pending      atomic.Int64
+	active       atomic.Int64
+	done         atomic.Int64
+	offers       atomic.Int64
+	doneDuration atomic.Int64 // total time spent verifying the done dates, in nanoseconds
+}
+
+// ProgressSnapshot is the state of a search at one point in time.
+type ProgressSnapshot struct {
+	Pending int // dates waiting to be verified
+	Active  int // dates being verified
+	Done    int // dates verified, including failed ones
+	Offers  int // offers below Google's low price found so far
+
+	AverageDateDuration time.Duration // average time to verify a date, 0 until a date is done
+}
+
+// Snapshot returns the current state of the search.
+func (p *Progress) Snapshot() ProgressSnapshot {
+	if p == nil {
+		return ProgressSnapshot{}
+	}
+	snapshot := ProgressSnapshot{
+		Pending: int(p.pending.Load()),
+		Active:  int(p.active.Load()),
+		Done:    int(p.done.Load()),
+		Offers:  int(p.offers.Load()),
+	}
+	if snapshot.Done > 0 {
+		snapshot.AverageDateDuration = time.Duration(p.doneDuration.Load() / int64(snapshot.Done))
+	}
+	return snapshot
+}
+
+func (p *Progress) queued(dates int) {
+	if p != nil {
+		p.pending.Add(int64(dates))
+	}
+}
+
+// started marks a date as active and returns the function marking it done.
+func (p *Progress) started() func() {
+	if p == nil {
+		return func() {}
+	}
+	p.pending.Add(-1)
+	p.active.Add(1)
+	start := time.Now()
+	return func() {
+		p.doneDuration.Add(int64(time.Since(start)))
+		p.active.Add(-1)
+		p.done.Add(1)
+	}
+}
+
+// found counts an offer below Google's low price.
+func (p *Progress) found() {
+	if p != nil {
+		p.offers.Add(1)
+	}
+}
@@ -0,0 +1,146 @@
+package cheapoffers
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// RequestStats counts the upstream requests of a search, so that the cost of its
+// parameters can be compared.
+type RequestStats struct {
+	PriceGraph      int           // price graph requests sent upstream
+	Offers          int           // offer searches sent upstream
+	Links           int           // shareable links serialized
+	CacheHits       int           // price graph requests and offer searches answered by Args.Cache
+	Retries         int           // failed requests sent again, counted in PriceGraph and Offers too
+	UpstreamLatency time.Duration // time spent waiting for upstream requests, summed over concurrent requests
+	Elapsed         time.Duration // wall time of the search
+}
+
+// Upstream returns the number of requests sent upstream.
+func (r RequestStats) Upstream() int {
+	return r.PriceGraph + r.Offers + r.Links
+}
+
+// meteredSession counts the requests of one search and retries the failed price
+// graph requests and offer searches, see Args.Retries.
+type meteredSession struct {
+	Session
+	priceGraph, offers, links, cacheHits atomic.Int64
+	latency                              atomic.Int64 // nanoseconds
+
+	retries  int           // Args.Retries
+	backoff  time.Duration // wait before the first retry
+	deadline time.Time     // Args.Deadline
+	limited  bool          // retries are limited by retryBudget
+	// retryBudget is the number of retries left within Args.MaxRequests, it's only
+	// used if limited is set.
+	retryBudget atomic.Int64
+	retried     atomic.Int64
+}
+
+func newMeteredSession(upstream Session, args Args) *meteredSession {
+	m := &meteredSession{Session: upstream, retries: args.Retries, backoff: args.RetryBackoff, deadline: args.Deadline}
+	if m.backoff <= 0 {
+		m.backoff = DefaultRetryBackoff
+	}
+	if args.MaxRequests > 0 {
+		// Until the dates are planned, the price graphs may retry as long as one date
+		// can still be verified.
+		m.limited = true
+		m.retryBudget.Store(int64(args.MaxRequests - len(args.TripLengths) - args.dateRequests()))
+	}
+	return m
+}
+
+func (m *meteredSession) GetPriceGraph(ctx context.Context, args flights.PriceGraphArgs) ([]flights.Offer, error) {
+	var offers []flights.Offer
+	err := m.retry(ctx, func() (err error) {
+		m.priceGraph.Add(1)
+		defer m.measure(time.Now())
+		offers, err = m.Session.GetPriceGraph(ctx, args)
+		return err
+	})
+	return offers, err
+}
+
+func (m *meteredSession) GetOffers(ctx context.Context, args flights.Args) ([]flights.FullOffer, *flights.PriceRange, error) {
+	var offers []flights.FullOffer
+	var priceRange *flights.PriceRange
+	err := m.retry(ctx, func() (err error) {
+		m.offers.Add(1)
+		defer m.measure(time.Now())
+		offers, priceRange, err = m.Session.GetOffers(ctx, args)
+		return err
+	})
+	return offers, priceRange, err
+}
+
+// retry calls f until it succeeds or m.retries retries failed. The wait before a
+// retry doubles with every retry, starting at m.backoff. There's no retry once ctx
+// is done, if the wait would end after the deadline or if the retry budget is used
+// up.
+func (m *meteredSession) retry(ctx context.Context, f func() error) error {
+	wait := m.backoff
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if err == nil || attempt == m.retries || ctx.Err() != nil {
+			return err
+		}
+		if !m.deadline.IsZero() && time.Now().Add(wait).After(m.deadline) {
+			return err
+		}
+		if m.limited && m.retryBudget.Add(-1) < 0 {
+			return err
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		m.retried.Add(1)
+		wait *= 2
+	}
+}
+
+// planRetries sets the retry budget to the requests of Args.MaxRequests the planned
+// requests leave.
+func (m *meteredSession) planRetries(args Args, dates int) {
+	if m.limited {
+		m.retryBudget.Store(int64(args.MaxRequests - len(args.TripLengths) - int(m.retried.Load()) - dates*args.dateRequests()))
+	}
+}
+
+func (m *meteredSession) SerializeURL(ctx context.Context, args flights.Args) (string, error) {
+	m.links.Add(1)
+	defer m.measure(time.Now())
+	return m.Session.SerializeURL(ctx, args)
+}
+
+func (m *meteredSession) measure(start time.Time) {
+	m.latency.Add(int64(time.Since(start)))
+}
+
+// cached records a request answered by the cache if cached is set.
+func (m *meteredSession) cached(cached bool) {
+	if cached {
+		m.cacheHits.Add(1)
+	}
+}
+
+func (m *meteredSession) stats(start time.Time) RequestStats {
+	return RequestStats{
+		PriceGraph:      int(m.priceGraph.Load()),
+		Offers:          int(m.offers.Load()),
+		Links:           int(m.links.Load()),
+		CacheHits:       int(m.cacheHits.Load()),
+		Retries:         int(m.retried.Load()),
+		UpstreamLatency: time.Duration(m.latency.Load()),
+		Elapsed:         time.Since(start),
+	}
+}
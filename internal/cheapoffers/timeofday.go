@@ -0,0 +1,71 @@
+package cheapoffers
+
+import "fmt"
+
+// TimeBucket is a named range of local departure hours [StartHour, EndHour). A bucket
+// whose StartHour is greater than its EndHour wraps around midnight.
+type TimeBucket struct {
+	Name      string
+	StartHour int
+	EndHour   int
+}
+
+// DefaultTimeBuckets splits the day into red-eye, morning, afternoon and evening departures.
+var DefaultTimeBuckets = []TimeBucket{
+	{Name: "red-eye", StartHour: 0, EndHour: 6},
+	{Name: "morning", StartHour: 6, EndHour: 12},
+	{Name: "afternoon", StartHour: 12, EndHour: 18},
+	{Name: "evening", StartHour: 18, EndHour: 24},
+}
+
+// Contains reports whether the hour (0-23) falls into the bucket.
+func (b TimeBucket) Contains(hour int) bool {
+	if b.StartHour <= b.EndHour {
+		return hour >= b.StartHour && hour < b.EndHour
+	}
+	return hour >= b.StartHour || hour < b.EndHour
+}
+
+// ValidateTimeBuckets checks that the buckets have names and valid hours.
+func ValidateTimeBuckets(buckets []TimeBucket) error {
+	for _, b := range buckets {
+		if b.Name == "" {
+			return fmt.Errorf("time bucket name is required")
+		}
+		if b.StartHour < 0 || b.StartHour > 23 || b.EndHour < 0 || b.EndHour > 24 || b.StartHour == b.EndHour {
+			return fmt.Errorf("time bucket %q has an invalid hour range %d-%d", b.Name, b.StartHour, b.EndHour)
+		}
+	}
+	return nil
+}
+
+// BucketResult is the cheapest result departing within a time bucket.
+type BucketResult struct {
+	Bucket   TimeBucket
+	Count    int     // number of results departing within the bucket
+	Cheapest *Result // nil if no result departs within the bucket
+}
+
+// CheapestByDepartureTime assigns every result to the first bucket containing its local
+// departure hour and returns the cheapest result per bucket, in bucket order.
+func CheapestByDepartureTime(results []Result, buckets []TimeBucket) []BucketResult {
+	bucketResults := make([]BucketResult, len(buckets))
+	for i, b := range buckets {
+		bucketResults[i].Bucket = b
+	}
+
+	for i := range results {
+		hour := results[i].StartDate.Hour()
+		for j := range bucketResults {
+			if !bucketResults[j].Bucket.Contains(hour) {
+				continue
+			}
+			bucketResults[j].Count++
+			if bucketResults[j].Cheapest == nil || results[i].Price < bucketResults[j].Cheapest.Price {
+				bucketResults[j].Cheapest = &results[i]
+			}
+			break
+		}
+	}
+	return bucketResults
+}
@@ -0,0 +1,59 @@
+package cheapoffers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheapestByDepartureTime(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2024, 4, 1, hour, 30, 0, 0, time.UTC) }
+
+	results := []Result{
+		{StartDate: at(7), Price: 300},
+		{StartDate: at(9), Price: 200},
+		{StartDate: at(23), Price: 150},
+		{StartDate: at(2), Price: 100},
+	}
+
+	buckets := CheapestByDepartureTime(results, DefaultTimeBuckets)
+	if len(buckets) != 4 {
+		t.Fatalf("expected 4 buckets, got %d", len(buckets))
+	}
+
+	expected := map[string]struct {
+		count int
+		price float64
+	}{
+		"red-eye":   {1, 100},
+		"morning":   {2, 200},
+		"afternoon": {0, 0},
+		"evening":   {1, 150},
+	}
+	for _, b := range buckets {
+		e := expected[b.Bucket.Name]
+		if b.Count != e.count {
+			t.Errorf("%s: expected %d results, got %d", b.Bucket.Name, e.count, b.Count)
+		}
+		if e.count == 0 && b.Cheapest != nil {
+			t.Errorf("%s: expected no cheapest result", b.Bucket.Name)
+		}
+		if e.count > 0 && (b.Cheapest == nil || b.Cheapest.Price != e.price) {
+			t.Errorf("%s: expected cheapest price %v, got %+v", b.Bucket.Name, e.price, b.Cheapest)
+		}
+	}
+}
+
+func TestTimeBucketWrapsMidnight(t *testing.T) {
+	b := TimeBucket{Name: "night", StartHour: 22, EndHour: 6}
+	for hour, expected := range map[int]bool{21: false, 22: true, 23: true, 0: true, 5: true, 6: false} {
+		if b.Contains(hour) != expected {
+			t.Errorf("Contains(%d) = %v, expected %v", hour, !expected, expected)
+		}
+	}
+	if err := ValidateTimeBuckets([]TimeBucket{b}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateTimeBuckets([]TimeBucket{{Name: "bad", StartHour: 5, EndHour: 5}}); err == nil {
+		t.Fatal("expected error for empty bucket")
+	}
+}
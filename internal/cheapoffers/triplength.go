@@ -0,0 +1,67 @@
+package cheapoffers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// TripLengthStats is the cheapest price graph offer of a trip length.
+type TripLengthStats struct {
+	TripLength  int
+	MinPrice    float64   // zero if no departure date had a price
+	MinDate     time.Time // departure date of the cheapest offer
+	PricePerDay float64   // MinPrice divided by TripLength
+
+	// MarginalPerDay is the price of every day added to the previous priced trip
+	// length, MinPrice minus its MinPrice divided by the added days. It's zero
+	// for the shortest priced length and may be negative.
+	MarginalPerDay float64
+}
+
+// PricesByTripLength returns the cheapest offer of every trip length of graphs,
+// which maps trip lengths to their price graphs, ordered by trip length.
+func PricesByTripLength(graphs map[int][]flights.Offer) []TripLengthStats {
+	stats := make([]TripLengthStats, 0, len(graphs))
+	for tripLength, offers := range graphs {
+		st := TripLengthStats{TripLength: tripLength}
+		for _, o := range offers {
+			if o.Price > 0 && (st.MinPrice == 0 || o.Price < st.MinPrice) {
+				st.MinPrice = o.Price
+				st.MinDate = o.StartDate
+			}
+		}
+		if st.MinPrice > 0 {
+			st.PricePerDay = st.MinPrice / float64(tripLength)
+		}
+		stats = append(stats, st)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].TripLength < stats[j].TripLength })
+
+	var previous *TripLengthStats
+	for i := range stats {
+		if stats[i].MinPrice == 0 {
+			continue
+		}
+		if previous != nil {
+			stats[i].MarginalPerDay = (stats[i].MinPrice - previous.MinPrice) / float64(stats[i].TripLength-previous.TripLength)
+		}
+		previous = &stats[i]
+	}
+	return stats
+}
+
+// RecommendTripLength returns the index of the trip length with the lowest price
+// per day, or -1 if none has a price. Staying longer lowers the price per day as
+// long as the added days cost less than the days before them on average, so it's
+// the length after which further days stop paying off. Ties go to the shorter trip.
+func RecommendTripLength(stats []TripLengthStats) int {
+	best := -1
+	for i, st := range stats {
+		if st.MinPrice > 0 && (best < 0 || st.PricePerDay < stats[best].PricePerDay) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,43 @@
+package cheapoffers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestPricesByTripLength(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 7, d, 0, 0, 0, 0, time.UTC) }
+
+	stats := PricesByTripLength(map[int][]flights.Offer{
+		10: {{StartDate: day(3), Price: 700}, {StartDate: day(4), Price: 650}},
+		4:  {{StartDate: day(1), Price: 400}, {StartDate: day(2), Price: 0}},
+		7:  {{StartDate: day(5), Price: 460}},
+		5:  {{StartDate: day(6), Price: 0}}, // no price, skipped by the marginal cost
+	})
+
+	if len(stats) != 4 || stats[0].TripLength != 4 || stats[3].TripLength != 10 {
+		t.Fatalf("wrong trip lengths: %+v", stats)
+	}
+	if stats[0].MinPrice != 400 || stats[0].PricePerDay != 100 || stats[0].MarginalPerDay != 0 || !stats[0].MinDate.Equal(day(1)) {
+		t.Fatalf("wrong stats of 4 days: %+v", stats[0])
+	}
+	if stats[1].MinPrice != 0 || stats[1].PricePerDay != 0 {
+		t.Fatalf("expected 5 days without a price: %+v", stats[1])
+	}
+	if stats[2].MarginalPerDay != 20 {
+		t.Fatalf("expected 3 more days for 60, got %+v", stats[2])
+	}
+	if stats[3].MinPrice != 650 || stats[3].PricePerDay != 65 || stats[3].MarginalPerDay != 190.0/3 {
+		t.Fatalf("wrong stats of 10 days: %+v", stats[3])
+	}
+
+	// 7 days cost 65.71 a day, 10 days 65.
+	if best := RecommendTripLength(stats); best != 3 {
+		t.Fatalf("expected 10 days to be recommended, got %d", best)
+	}
+	if best := RecommendTripLength(stats[1:2]); best != -1 {
+		t.Fatalf("expected no recommendation without prices, got %d", best)
+	}
+}
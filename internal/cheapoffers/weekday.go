@@ -0,0 +1,47 @@
+package cheapoffers
+
+import (
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+// WeekdayStats aggregates price graph offers departing on the same weekday.
+type WeekdayStats struct {
+	Weekday      time.Weekday
+	Samples      int     // number of departure dates with a price
+	AveragePrice float64 // mean price of all samples
+	MinPrice     float64
+	MinDate      time.Time // departure date of the cheapest sample
+}
+
+// PricesByWeekday groups price graph offers by departure weekday. The result always
+// contains seven entries ordered from Monday to Sunday; weekdays without any priced
+// offer have zero samples.
+func PricesByWeekday(offers []flights.Offer) []WeekdayStats {
+	stats := make([]WeekdayStats, 7)
+	sums := make([]float64, 7)
+	for i := range stats {
+		stats[i].Weekday = time.Weekday((i + 1) % 7)
+	}
+
+	for _, o := range offers {
+		if o.Price <= 0 {
+			continue
+		}
+		i := (int(o.StartDate.Weekday()) + 6) % 7
+		if stats[i].Samples == 0 || o.Price < stats[i].MinPrice {
+			stats[i].MinPrice = o.Price
+			stats[i].MinDate = o.StartDate
+		}
+		stats[i].Samples++
+		sums[i] += o.Price
+	}
+
+	for i := range stats {
+		if stats[i].Samples > 0 {
+			stats[i].AveragePrice = sums[i] / float64(stats[i].Samples)
+		}
+	}
+	return stats
+}
@@ -0,0 +1,41 @@
+package cheapoffers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krisukox/google-flights-api/flights"
+)
+
+func TestPricesByWeekday(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 4, d, 0, 0, 0, 0, time.UTC) } // 2024-04-01 is a Monday
+
+	stats := PricesByWeekday([]flights.Offer{
+		{StartDate: day(1), Price: 200},
+		{StartDate: day(8), Price: 100},
+		{StartDate: day(2), Price: 150},
+		{StartDate: day(7), Price: 300},
+		{StartDate: day(3), Price: 0}, // no price, ignored
+	})
+
+	if len(stats) != 7 {
+		t.Fatalf("expected 7 weekdays, got %d", len(stats))
+	}
+	if stats[0].Weekday != time.Monday || stats[6].Weekday != time.Sunday {
+		t.Fatalf("wrong weekday order: %v ... %v", stats[0].Weekday, stats[6].Weekday)
+	}
+
+	monday := stats[0]
+	if monday.Samples != 2 || monday.AveragePrice != 150 || monday.MinPrice != 100 || !monday.MinDate.Equal(day(8)) {
+		t.Fatalf("wrong monday stats: %+v", monday)
+	}
+	if stats[1].Samples != 1 || stats[1].MinPrice != 150 {
+		t.Fatalf("wrong tuesday stats: %+v", stats[1])
+	}
+	if stats[2].Samples != 0 {
+		t.Fatalf("wednesday should have no samples: %+v", stats[2])
+	}
+	if stats[6].Samples != 1 || stats[6].AveragePrice != 300 {
+		t.Fatalf("wrong sunday stats: %+v", stats[6])
+	}
+}
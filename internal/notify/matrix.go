@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Matrix posts messages to a Matrix room using the client-server API.
+type Matrix struct {
+	Homeserver  string // base URL of the homeserver, e.g. https://matrix.org
+	RoomID      string // room ID, e.g. !abcdef:matrix.org
+	AccessToken string // access token of the sending user
+
+	Client *http.Client // defaults to a client with a 10s timeout
+
+	txn atomic.Int64
+}
+
+type matrixEvent struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// Notify implements [Notifier].
+func (m *Matrix) Notify(ctx context.Context, msg Message) error {
+	if m.Homeserver == "" || m.RoomID == "" || m.AccessToken == "" {
+		return fmt.Errorf("matrix: homeserver, room ID and access token are required")
+	}
+
+	body, err := json.Marshal(matrixEvent{MsgType: "m.text", Body: msg.Text()})
+	if err != nil {
+		return fmt.Errorf("matrix: encode event: %w", err)
+	}
+
+	// The transaction ID makes retries of the same request idempotent on the homeserver.
+	txnID := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + strconv.FormatInt(m.txn.Add(1), 10)
+	endpoint := strings.TrimRight(m.Homeserver, "/") +
+		"/_matrix/client/v3/rooms/" + url.PathEscape(m.RoomID) +
+		"/send/m.room.message/" + txnID
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("matrix: create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+m.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := m.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("matrix: send message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("matrix: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
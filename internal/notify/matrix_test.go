@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMatrixNotify(t *testing.T) {
+	var got matrixEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("wrong method: %s", r.Method)
+		}
+		if !strings.HasPrefix(r.URL.EscapedPath(), "/_matrix/client/v3/rooms/%21room:example.org/send/m.room.message/") {
+			t.Errorf("wrong path: %s", r.URL.EscapedPath())
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer secret" {
+			t.Errorf("wrong authorization header: %s", auth)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.Write([]byte(`{"event_id":"$1"}`))
+	}))
+	defer srv.Close()
+
+	m := &Matrix{Homeserver: srv.URL + "/", RoomID: "!room:example.org", AccessToken: "secret"}
+	if err := m.Notify(context.Background(), Message{Title: "Price drop", Body: "SFO -> JFK 199 USD"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.MsgType != "m.text" {
+		t.Fatalf("wrong msgtype: %s", got.MsgType)
+	}
+	if got.Body != "Price drop\n\nSFO -> JFK 199 USD" {
+		t.Fatalf("wrong body: %q", got.Body)
+	}
+}
+
+func TestMatrixNotifyError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errcode":"M_FORBIDDEN"}`, http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	m := &Matrix{Homeserver: srv.URL, RoomID: "!room:example.org", AccessToken: "secret"}
+	err := m.Notify(context.Background(), Message{Body: "hello"})
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected status error, got: %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+// Package notify delivers short human-readable messages to chat systems.
+//
+// Every chat system is an implementation of [Notifier]. Code that raises events
+// only depends on the interface, so support for a new chat system is added by
+// writing a new implementation without touching the callers.
+package notify
+
+import (
+	"context"
+	"errors"
+)
+
+// Message is a single notification.
+type Message struct {
+	Title string // short headline, e.g. "Price drop: SFO -> JFK"
+	Body  string // plain text details
+}
+
+// Text renders the message as plain text.
+func (m Message) Text() string {
+	if m.Title == "" {
+		return m.Body
+	}
+	if m.Body == "" {
+		return m.Title
+	}
+	return m.Title + "\n\n" + m.Body
+}
+
+// Notifier sends messages to a single destination.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Multi fans a message out to all of its notifiers. It tries every notifier
+// even if some of them fail and returns the joined errors.
+type Multi []Notifier
+
+// Notify implements [Notifier].
+func (m Multi) Notify(ctx context.Context, msg Message) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.Notify(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,58 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Slack posts messages to a Slack channel through an incoming webhook.
+type Slack struct {
+	WebhookURL string // e.g. https://hooks.slack.com/services/T000/B000/XXXX
+
+	Client *http.Client // defaults to a client with a 10s timeout
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// Notify implements [Notifier].
+func (s *Slack) Notify(ctx context.Context, msg Message) error {
+	if s.WebhookURL == "" {
+		return fmt.Errorf("slack: webhook URL is required")
+	}
+
+	body, err := json.Marshal(slackMessage{Text: msg.Text()})
+	if err != nil {
+		return fmt.Errorf("slack: encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("slack: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		// The error contains the webhook URL, which is a secret.
+		return fmt.Errorf("slack: send message: request failed")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("slack: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
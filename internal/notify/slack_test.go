@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSlackNotify(t *testing.T) {
+	var got slackMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("wrong method: %s", r.Method)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	s := &Slack{WebhookURL: srv.URL + "/services/T000/B000/XXXX"}
+	if err := s.Notify(context.Background(), Message{Title: "Weekly deals", Body: "SFO -> JFK 199 USD"}); err != nil {
+		t.Fatal(err)
+	}
+	if got.Text != "Weekly deals\n\nSFO -> JFK 199 USD" {
+		t.Fatalf("wrong text: %q", got.Text)
+	}
+}
+
+func TestSlackNotifyError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_token", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	s := &Slack{WebhookURL: srv.URL}
+	err := s.Notify(context.Background(), Message{Body: "hello"})
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected status error, got: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+// Package policy contains deployment-level rules that restrict what searches
+// may be run and which offers are acceptable.
+package policy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Violation is returned when a request breaks a deployment policy.
+type Violation struct {
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return "policy violation: " + v.Reason
+}
+
+// Route restricts the searchable origins and destinations. Entries are city names
+// or IATA airport codes and are compared case-insensitively with the requested
+// locations. An empty allow list allows everything that is not denied.
+type Route struct {
+	AllowedOrigins      []string
+	DeniedOrigins       []string
+	AllowedDestinations []string
+	DeniedDestinations  []string
+}
+
+// Empty reports whether the policy has no rules.
+func (r Route) Empty() bool {
+	return len(r.AllowedOrigins) == 0 && len(r.DeniedOrigins) == 0 &&
+		len(r.AllowedDestinations) == 0 && len(r.DeniedDestinations) == 0
+}
+
+// Check returns a [*Violation] if any of the origins or destinations is not permitted.
+func (r Route) Check(origins, destinations []string) error {
+	if err := checkLocations("origin", origins, r.AllowedOrigins, r.DeniedOrigins); err != nil {
+		return err
+	}
+	return checkLocations("destination", destinations, r.AllowedDestinations, r.DeniedDestinations)
+}
+
+func checkLocations(kind string, locations, allowed, denied []string) error {
+	for _, l := range locations {
+		if contains(denied, l) {
+			return &Violation{Reason: fmt.Sprintf("%s %q is denied on this deployment", kind, l)}
+		}
+		if len(allowed) > 0 && !contains(allowed, l) {
+			return &Violation{Reason: fmt.Sprintf("%s %q is not allowed on this deployment (allowed: %s)",
+				kind, l, strings.Join(allowed, ", "))}
+		}
+	}
+	return nil
+}
+
+func contains(list []string, location string) bool {
+	location = strings.TrimSpace(location)
+	for _, l := range list {
+		if strings.EqualFold(l, location) {
+			return true
+		}
+	}
+	return false
+}
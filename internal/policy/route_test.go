@@ -0,0 +1,35 @@
+package policy
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRouteCheck(t *testing.T) {
+	r := Route{
+		AllowedOrigins:     []string{"Berlin", "MUC"},
+		DeniedDestinations: []string{"Moscow"},
+	}
+
+	tests := []struct {
+		origins, destinations []string
+		violation             bool
+	}{
+		{[]string{"berlin"}, []string{"London"}, false},
+		{[]string{"Berlin", "muc"}, []string{"London"}, false},
+		{[]string{"Hamburg"}, []string{"London"}, true},
+		{[]string{"Berlin"}, []string{"London", "MOSCOW"}, true},
+	}
+
+	for _, test := range tests {
+		err := r.Check(test.origins, test.destinations)
+		var v *Violation
+		if got := errors.As(err, &v); got != test.violation {
+			t.Errorf("Check(%v, %v) = %v, expected violation: %v", test.origins, test.destinations, err, test.violation)
+		}
+	}
+
+	if err := (Route{}).Check([]string{"Anywhere"}, []string{"Else"}); err != nil {
+		t.Fatalf("empty policy should allow everything: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Cabin names accepted in [Travel.AllowedCabins].
+const (
+	CabinEconomy        = "economy"
+	CabinPremiumEconomy = "premium-economy"
+	CabinBusiness       = "business"
+	CabinFirst          = "first"
+)
+
+// Travel is a corporate travel policy. It is loaded from a JSON file, e.g.
+//
+//	{
+//	  "currency": "EUR",
+//	  "maxPrice": 900,
+//	  "regions": [{"name": "domestic", "destinations": ["BER", "Munich"], "maxPrice": 250}],
+//	  "allowedCabins": ["economy", "premium-economy"],
+//	  "minAdvanceDays": 14
+//	}
+type Travel struct {
+	Currency       string   `json:"currency"`       // currency of all price limits, offers in other currencies can't be checked
+	MaxPrice       float64  `json:"maxPrice"`       // price limit for destinations outside of every region, 0 means no limit
+	Regions        []Region `json:"regions"`        // price limits for groups of destinations, the first match wins
+	AllowedCabins  []string `json:"allowedCabins"`  // empty allows every cabin
+	MinAdvanceDays int      `json:"minAdvanceDays"` // minimum number of days between booking and departure
+}
+
+// Region groups destinations (city names or IATA airport codes) under one price limit.
+type Region struct {
+	Name         string   `json:"name"`
+	Destinations []string `json:"destinations"`
+	MaxPrice     float64  `json:"maxPrice"`
+}
+
+// Itinerary contains the offer attributes checked by a [Travel] policy.
+type Itinerary struct {
+	DstAirport    string
+	DstCity       string
+	Price         float64
+	Currency      string
+	Cabin         string
+	DepartureDate time.Time
+}
+
+// Compliance is the result of [Travel.Evaluate].
+type Compliance struct {
+	Compliant bool
+	Reasons   []string // why the itinerary is not compliant
+}
+
+// LoadTravel reads and validates a travel policy file.
+func LoadTravel(path string) (*Travel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read travel policy: %w", err)
+	}
+	var t Travel
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("parse travel policy: %w", err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("travel policy: %w", err)
+	}
+	return &t, nil
+}
+
+// Validate checks that the policy is well-formed.
+func (t *Travel) Validate() error {
+	if (t.MaxPrice > 0 || len(t.Regions) > 0) && t.Currency == "" {
+		return fmt.Errorf("currency is required when price limits are set")
+	}
+	if t.MaxPrice < 0 {
+		return fmt.Errorf("maxPrice cannot be negative")
+	}
+	for _, r := range t.Regions {
+		if len(r.Destinations) == 0 {
+			return fmt.Errorf("region %q has no destinations", r.Name)
+		}
+		if r.MaxPrice <= 0 {
+			return fmt.Errorf("region %q needs a positive maxPrice", r.Name)
+		}
+	}
+	for _, c := range t.AllowedCabins {
+		switch c {
+		case CabinEconomy, CabinPremiumEconomy, CabinBusiness, CabinFirst:
+		default:
+			return fmt.Errorf("unknown cabin %q", c)
+		}
+	}
+	if t.MinAdvanceDays < 0 {
+		return fmt.Errorf("minAdvanceDays cannot be negative")
+	}
+	return nil
+}
+
+// Evaluate checks the itinerary against every rule of the policy. now is the
+// booking time used for the advance-purchase rule.
+func (t *Travel) Evaluate(it Itinerary, now time.Time) Compliance {
+	var reasons []string
+
+	limit, limitName := t.MaxPrice, "default"
+	for _, r := range t.Regions {
+		if contains(r.Destinations, it.DstAirport) || (it.DstCity != "" && contains(r.Destinations, it.DstCity)) {
+			limit, limitName = r.MaxPrice, r.Name
+			break
+		}
+	}
+	if limit > 0 {
+		if !strings.EqualFold(it.Currency, t.Currency) {
+			reasons = append(reasons, fmt.Sprintf("price in %s cannot be checked against the %s policy limit", it.Currency, t.Currency))
+		} else if it.Price > limit {
+			reasons = append(reasons, fmt.Sprintf("price %.0f %s exceeds the %s limit of %.0f %s", it.Price, it.Currency, limitName, limit, t.Currency))
+		}
+	}
+
+	if len(t.AllowedCabins) > 0 && !contains(t.AllowedCabins, it.Cabin) {
+		reasons = append(reasons, fmt.Sprintf("cabin %s is not allowed (allowed: %s)", it.Cabin, strings.Join(t.AllowedCabins, ", ")))
+	}
+
+	if t.MinAdvanceDays > 0 {
+		today := now.Truncate(24 * time.Hour)
+		days := int(it.DepartureDate.Truncate(24*time.Hour).Sub(today).Hours() / 24)
+		if days < t.MinAdvanceDays {
+			reasons = append(reasons, fmt.Sprintf("departure is %d day(s) ahead, policy requires booking at least %d day(s) in advance", days, t.MinAdvanceDays))
+		}
+	}
+
+	return Compliance{Compliant: len(reasons) == 0, Reasons: reasons}
+}
@@ -0,0 +1,56 @@
+package policy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTravelEvaluate(t *testing.T) {
+	p := &Travel{
+		Currency:       "EUR",
+		MaxPrice:       900,
+		Regions:        []Region{{Name: "domestic", Destinations: []string{"BER", "Munich"}, MaxPrice: 250}},
+		AllowedCabins:  []string{CabinEconomy},
+		MinAdvanceDays: 14,
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	departure := time.Date(2024, 4, 1, 7, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		it      Itinerary
+		reasons int
+	}{
+		{"compliant", Itinerary{DstAirport: "LHR", Price: 400, Currency: "EUR", Cabin: CabinEconomy, DepartureDate: departure}, 0},
+		{"region limit by airport", Itinerary{DstAirport: "BER", Price: 300, Currency: "EUR", Cabin: CabinEconomy, DepartureDate: departure}, 1},
+		{"region limit by city", Itinerary{DstAirport: "MUC", DstCity: "munich", Price: 300, Currency: "EUR", Cabin: CabinEconomy, DepartureDate: departure}, 1},
+		{"default limit", Itinerary{DstAirport: "JFK", Price: 1000, Currency: "EUR", Cabin: CabinEconomy, DepartureDate: departure}, 1},
+		{"currency mismatch", Itinerary{DstAirport: "JFK", Price: 100, Currency: "USD", Cabin: CabinEconomy, DepartureDate: departure}, 1},
+		{"cabin and advance purchase", Itinerary{DstAirport: "LHR", Price: 400, Currency: "EUR", Cabin: CabinBusiness, DepartureDate: now.AddDate(0, 0, 3)}, 2},
+	}
+
+	for _, test := range tests {
+		c := p.Evaluate(test.it, now)
+		if len(c.Reasons) != test.reasons || c.Compliant != (test.reasons == 0) {
+			t.Errorf("%s: unexpected compliance %+v", test.name, c)
+		}
+	}
+}
+
+func TestTravelValidate(t *testing.T) {
+	invalid := []Travel{
+		{MaxPrice: 100},
+		{Currency: "EUR", Regions: []Region{{Name: "empty", MaxPrice: 100}}},
+		{AllowedCabins: []string{"coach"}},
+		{MinAdvanceDays: -1},
+	}
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected validation error for %+v", p)
+		}
+	}
+}
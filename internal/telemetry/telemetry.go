@@ -0,0 +1,179 @@
+// Package telemetry collects anonymous, aggregate usage statistics and reports them
+// to a configurable endpoint. Nothing is collected unless a Reporter is created,
+// which the server only does when telemetry is explicitly enabled.
+//
+// A report contains the server version and, per tool, the number of calls and
+// failed calls since the previous report. It never contains tool arguments,
+// results, client information or IP addresses.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ToolStats holds the usage of a single tool.
+type ToolStats struct {
+	Name   string `json:"name"`
+	Calls  int    `json:"calls"`
+	Errors int    `json:"errors"`
+}
+
+// Report is the payload posted to the telemetry endpoint.
+type Report struct {
+	Version     string      `json:"version"`
+	PeriodStart time.Time   `json:"periodStart"`
+	PeriodEnd   time.Time   `json:"periodEnd"`
+	Tools       []ToolStats `json:"tools"`
+}
+
+// Reporter aggregates tool calls and periodically posts them as a [Report].
+type Reporter struct {
+	Endpoint string       // URL the reports are posted to
+	Version  string       // version of the server
+	Client   *http.Client // defaults to a client with a 10s timeout
+
+	mu          sync.Mutex
+	periodStart time.Time
+	tools       map[string]*ToolStats
+}
+
+// NewReporter returns a Reporter posting to endpoint.
+func NewReporter(endpoint, version string) *Reporter {
+	return &Reporter{
+		Endpoint:    endpoint,
+		Version:     version,
+		periodStart: time.Now(),
+		tools:       map[string]*ToolStats{},
+	}
+}
+
+// RecordCall records a call of the named tool.
+func (r *Reporter) RecordCall(tool string, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.tools[tool]
+	if !ok {
+		stats = &ToolStats{Name: tool}
+		r.tools[tool] = stats
+	}
+	stats.Calls++
+	if failed {
+		stats.Errors++
+	}
+}
+
+// snapshot returns the report of the current period and starts a new one.
+func (r *Reporter) snapshot(now time.Time) Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := Report{
+		Version:     r.Version,
+		PeriodStart: r.periodStart,
+		PeriodEnd:   now,
+		Tools:       make([]ToolStats, 0, len(r.tools)),
+	}
+	for _, stats := range r.tools {
+		report.Tools = append(report.Tools, *stats)
+	}
+	sort.Slice(report.Tools, func(i, j int) bool {
+		return report.Tools[i].Name < report.Tools[j].Name
+	})
+
+	r.periodStart = now
+	r.tools = map[string]*ToolStats{}
+	return report
+}
+
+// restore adds the counts of a report that couldn't be sent back to the current period.
+func (r *Reporter) restore(report Report) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.periodStart = report.PeriodStart
+	for _, s := range report.Tools {
+		stats, ok := r.tools[s.Name]
+		if !ok {
+			stats = &ToolStats{Name: s.Name}
+			r.tools[s.Name] = stats
+		}
+		stats.Calls += s.Calls
+		stats.Errors += s.Errors
+	}
+}
+
+// Flush posts the report of the current period. If sending fails, the counts are
+// kept and included in the next report.
+func (r *Reporter) Flush(ctx context.Context) error {
+	report := r.snapshot(time.Now())
+	if len(report.Tools) == 0 {
+		return nil
+	}
+	if err := r.send(ctx, report); err != nil {
+		r.restore(report)
+		return err
+	}
+	return nil
+}
+
+// Run flushes the reporter every interval until ctx is done.
+func (r *Reporter) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func (r *Reporter) send(ctx context.Context, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("telemetry: encode report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("telemetry: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := r.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		// The endpoint may carry credentials, keep it out of the error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("telemetry: send report: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("telemetry: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
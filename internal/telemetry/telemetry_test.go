@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestFlush(t *testing.T) {
+	var reports []Report
+	fail := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var report Report
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			t.Error(err)
+		}
+		reports = append(reports, report)
+	}))
+	defer srv.Close()
+
+	r := NewReporter(srv.URL, "0.1.0")
+	if err := r.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 0 {
+		t.Fatal("an empty period should not be reported")
+	}
+
+	r.RecordCall("recheck_offer", false)
+	r.RecordCall("find_cheapest_offers", true)
+	if err := r.Flush(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	// The counts of the failed report are sent with the next one.
+	fail = false
+	r.RecordCall("recheck_offer", false)
+	if err := r.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	if reports[0].Version != "0.1.0" {
+		t.Fatalf("wrong version: %s", reports[0].Version)
+	}
+	expected := []ToolStats{
+		{Name: "find_cheapest_offers", Calls: 1, Errors: 1},
+		{Name: "recheck_offer", Calls: 2},
+	}
+	if !reflect.DeepEqual(reports[0].Tools, expected) {
+		t.Fatalf("wrong tool stats: %+v", reports[0].Tools)
+	}
+}